@@ -5,18 +5,27 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 
+	"github.com/cometbft/cometbft/mempool"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/desmos-labs/cosmos-go-wallet/types"
 )
@@ -25,44 +34,115 @@ import (
 type Client struct {
 	prefix    string
 	Codec     codec.Codec
-	RPCClient rpcclient.Client
-	GRPCConn  *grpc.ClientConn
 	txEncoder sdk.TxEncoder
 
+	// RPCClient and GRPCConn send each request to the preferred available endpoint among the configured ones,
+	// falling back to the other endpoints when a node cannot be reached
+	RPCClient rpcclient.Client
+	GRPCConn  grpc.ClientConnInterface
+
+	rpcPool  *endpointPool[rpcclient.Client]
+	grpcPool *endpointPool[grpc.ClientConnInterface]
+
+	// chainID is the chain id set inside the config, which is used instead of the one returned by the node
+	chainID string
+
+	nodeInfoMu sync.Mutex
+	nodeInfo   *NodeInfo
+
 	AuthClient authtypes.QueryClient
+	BankClient banktypes.QueryClient
 	TxClient   sdktx.ServiceClient
 
+	// GasPrice is the gas price used by default to pay fees, while GasPrices contains all the
+	// gas prices that can be used, in order of preference
 	GasPrice      sdk.DecCoin
+	GasPrices     []sdk.DecCoin
 	GasAdjustment float64
+
+	// FeeLimits contains the maximum gas and fees that a transaction can use before being signed
+	FeeLimits FeeLimits
+
+	// GasPriceProvider is used to get the gas price that should be used to compute the fees.
+	// By default, it always returns the gas price set inside the config.
+	GasPriceProvider GasPriceProvider
+
+	// TxPollInterval is the interval at which the chain is queried when waiting for a transaction to be included
+	TxPollInterval time.Duration
+
+	// RetryPolicy is the policy used to retry the requests that fail due to transient node errors
+	RetryPolicy RetryPolicy
+
+	// ReturnTxErrors tells whether a *TxError should be returned along with the response when a transaction
+	// that has been broadcast or waited for has a non-zero code
+	ReturnTxErrors bool
 }
 
 // NewClient returns a new Client instance
 func NewClient(config *types.ChainConfig, codec codec.Codec) (*Client, error) {
-	client, err := client.NewClientFromNode(config.RPCAddr)
-	if err != nil {
-		return nil, err
+	rpcAddrs := config.GetRPCAddrs()
+	if len(rpcAddrs) == 0 {
+		return nil, fmt.Errorf("at least one RPC address must be provided")
 	}
 
-	grpcConn, err := types.CreateGrpcConnection(config.GRPCAddr)
-	if err != nil {
-		return nil, fmt.Errorf("error while creating a GRPC connection: %s", err)
+	rpcClients := make([]rpcclient.Client, len(rpcAddrs))
+	for i, addr := range rpcAddrs {
+		rpcClient, err := client.NewClientFromNode(addr)
+		if err != nil {
+			return nil, err
+		}
+		rpcClients[i] = rpcClient
+	}
+
+	grpcAddrs := config.GetGRPCAddrs()
+	if len(grpcAddrs) == 0 {
+		return nil, fmt.Errorf("at least one gRPC address must be provided")
 	}
 
-	gasPrice, err := sdk.ParseDecCoin(config.GasPrice)
+	grpcConns := make([]grpc.ClientConnInterface, len(grpcAddrs))
+	for i, addr := range grpcAddrs {
+		grpcConn, err := types.CreateGrpcConnection(addr)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating a GRPC connection: %s", err)
+		}
+		grpcConns[i] = grpcConn
+	}
+
+	rpcPool := newEndpointPool(rpcAddrs, rpcClients)
+	grpcPool := newEndpointPool(grpcAddrs, grpcConns)
+	grpcConn := newFailoverGRPCConn(grpcPool)
+
+	gasPrices, err := config.GetGasPrices()
 	if err != nil {
 		return nil, fmt.Errorf("error while parsing gas price: %s", err)
 	}
 
+	feeLimits, err := NewFeeLimitsFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Client{
 		prefix:        config.Bech32Prefix,
+		chainID:       config.ChainID,
 		Codec:         codec,
-		RPCClient:     client,
-		GRPCConn:      grpcConn,
 		txEncoder:     tx.DefaultTxEncoder(),
+		RPCClient:     newFailoverRPCClient(rpcPool),
+		GRPCConn:      grpcConn,
+		rpcPool:       rpcPool,
+		grpcPool:      grpcPool,
 		AuthClient:    authtypes.NewQueryClient(grpcConn),
+		BankClient:    banktypes.NewQueryClient(grpcConn),
 		TxClient:      sdktx.NewServiceClient(grpcConn),
-		GasPrice:      gasPrice,
+		GasPrice:      gasPrices[0],
+		GasPrices:     gasPrices,
 		GasAdjustment: math.Max(config.GasAdjustment, 1.5),
+
+		FeeLimits:        feeLimits,
+		GasPriceProvider: NewStaticGasPriceProvider(gasPrices...),
+
+		TxPollInterval: time.Second,
+		RetryPolicy:    DefaultRetryPolicy(),
 	}, nil
 }
 
@@ -71,6 +151,53 @@ func (c *Client) GetAccountPrefix() string {
 	return c.prefix
 }
 
+// CheckEndpointsHealth queries all the configured endpoints to update their status, so that the following
+// requests are sent to the nodes that are available, caught up and at the highest block height
+func (c *Client) CheckEndpointsHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		c.rpcPool.checkHealth(ctx, checkRPCHealth)
+	}()
+	go func() {
+		defer wg.Done()
+		c.grpcPool.checkHealth(ctx, checkGRPCHealth)
+	}()
+	wg.Wait()
+}
+
+// StartHealthChecks checks the health of all the configured endpoints right away and then every interval,
+// until the given context is done. It does not block.
+func (c *Client) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			checkCtx, cancel := context.WithTimeout(ctx, interval)
+			c.CheckEndpointsHealth(checkCtx)
+			cancel()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RPCEndpoints returns the latest known status of the configured RPC endpoints
+func (c *Client) RPCEndpoints() []EndpointStatus {
+	return c.rpcPool.statuses()
+}
+
+// GRPCEndpoints returns the latest known status of the configured gRPC endpoints
+func (c *Client) GRPCEndpoints() []EndpointStatus {
+	return c.grpcPool.statuses()
+}
+
 // ParseAddress parses the given address as an sdk.AccAddress instance
 func (n *Client) ParseAddress(address string) (sdk.AccAddress, error) {
 	if len(strings.TrimSpace(address)) == 0 {
@@ -96,12 +223,105 @@ func (n *Client) ParseAddress(address string) (sdk.AccAddress, error) {
 
 // GetChainID returns the chain id associated to this client
 func (c *Client) GetChainID() (string, error) {
-	res, err := c.RPCClient.Status(context.Background())
+	return c.GetChainIDCtx(context.Background())
+}
+
+// GetChainIDCtx returns the chain id associated to this client using the given context.
+// If the chain id has been set inside the config it is returned directly, otherwise it is read from the
+// node only once and then cached.
+func (c *Client) GetChainIDCtx(ctx context.Context) (string, error) {
+	if c.chainID != "" {
+		return c.chainID, nil
+	}
+
+	nodeInfo, err := c.GetNodeInfoCtx(ctx)
 	if err != nil {
-		return "", fmt.Errorf("error while getting chain id: %s", err)
+		return "", err
 	}
 
-	return res.NodeInfo.Network, nil
+	return nodeInfo.ChainID, nil
+}
+
+// GetNodeInfo returns the information of the chain and the node this client is connected to
+func (c *Client) GetNodeInfo() (*NodeInfo, error) {
+	return c.GetNodeInfoCtx(context.Background())
+}
+
+// GetNodeInfoCtx returns the information of the chain and the node this client is connected to using the
+// given context. The information is read from the node only once and then cached, use RefreshNodeInfoCtx
+// to read it again (e.g. after a chain upgrade).
+func (c *Client) GetNodeInfoCtx(ctx context.Context) (*NodeInfo, error) {
+	c.nodeInfoMu.Lock()
+	defer c.nodeInfoMu.Unlock()
+
+	if c.nodeInfo != nil {
+		return c.nodeInfo, nil
+	}
+
+	return c.refreshNodeInfo(ctx)
+}
+
+// RefreshNodeInfo reads again the information of the chain and the node this client is connected to
+func (c *Client) RefreshNodeInfo() (*NodeInfo, error) {
+	return c.RefreshNodeInfoCtx(context.Background())
+}
+
+// RefreshNodeInfoCtx reads again the information of the chain and the node this client is connected to
+// using the given context
+func (c *Client) RefreshNodeInfoCtx(ctx context.Context) (*NodeInfo, error) {
+	c.nodeInfoMu.Lock()
+	defer c.nodeInfoMu.Unlock()
+
+	return c.refreshNodeInfo(ctx)
+}
+
+// refreshNodeInfo reads the node information and caches it. The caller must hold the nodeInfoMu lock.
+func (c *Client) refreshNodeInfo(ctx context.Context) (*NodeInfo, error) {
+	statusRes, err := withRetry(ctx, c.RetryPolicy, func() (*coretypes.ResultStatus, error) {
+		return c.RPCClient.Status(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while getting node status: %s", err)
+	}
+
+	abciInfoRes, err := withRetry(ctx, c.RetryPolicy, func() (*coretypes.ResultABCIInfo, error) {
+		return c.RPCClient.ABCIInfo(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while getting app info: %s", err)
+	}
+
+	chainID := statusRes.NodeInfo.Network
+	if c.chainID != "" {
+		chainID = c.chainID
+	}
+
+	c.nodeInfo = &NodeInfo{
+		ChainID:            chainID,
+		Moniker:            statusRes.NodeInfo.Moniker,
+		NodeVersion:        statusRes.NodeInfo.Version,
+		AppName:            abciInfoRes.Response.Data,
+		AppVersion:         abciInfoRes.Response.Version,
+		AppProtocolVersion: abciInfoRes.Response.AppVersion,
+	}
+
+	return c.nodeInfo, nil
+}
+
+// GetLatestHeight returns the height of the latest block of the chain
+func (c *Client) GetLatestHeight() (int64, error) {
+	return c.GetLatestHeightCtx(context.Background())
+}
+
+// GetLatestHeightCtx returns the height of the latest block of the chain, using the given context
+func (c *Client) GetLatestHeightCtx(ctx context.Context) (int64, error) {
+	res, err := withRetry(ctx, c.RetryPolicy, func() (*coretypes.ResultStatus, error) {
+		return c.RPCClient.Status(ctx)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error while getting latest height: %s", err)
+	}
+	return res.SyncInfo.LatestBlockHeight, nil
 }
 
 // GetFeeDenom returns the denom used to pay for fees, based on the gas price inside the config
@@ -109,16 +329,110 @@ func (c *Client) GetFeeDenom() string {
 	return c.GasPrice.Denom
 }
 
-// GetFees returns the fees that should be paid to perform a transaction with the given gas
+// GetFeeDenoms returns all the denoms that can be used to pay for fees, in order of preference
+func (c *Client) GetFeeDenoms() []string {
+	if len(c.GasPrices) == 0 {
+		return []string{c.GetFeeDenom()}
+	}
+
+	denoms := make([]string, len(c.GasPrices))
+	for i, gasPrice := range c.GasPrices {
+		denoms[i] = gasPrice.Denom
+	}
+	return denoms
+}
+
+// GetFees returns the fees that should be paid to perform a transaction with the given gas, based on the gas price
+// set inside the config.
+// The GasPriceProvider is not used: GetFeesCtx should be used instead to compute the fees based on its gas price.
 func (c *Client) GetFees(gas int64) sdk.Coins {
-	return sdk.NewCoins(sdk.NewCoin(c.GasPrice.Denom, c.GasPrice.Amount.MulInt64(gas).Ceil().RoundInt()))
+	return computeFees(c.GasPrice, gas)
+}
+
+// GetFeesCtx returns the fees that should be paid to perform a transaction with the given gas,
+// based on the gas price returned by the GasPriceProvider
+func (c *Client) GetFeesCtx(ctx context.Context, gas int64) (sdk.Coins, error) {
+	return c.GetFeesInDenomCtx(ctx, gas, c.GetFeeDenom())
+}
+
+// GetFeesInDenomCtx returns the fees that should be paid using the given denom to perform a transaction
+// with the given gas, based on the gas price returned by the GasPriceProvider
+func (c *Client) GetFeesInDenomCtx(ctx context.Context, gas int64, denom string) (sdk.Coins, error) {
+	if c.GasPriceProvider == nil {
+		if denom != c.GasPrice.Denom {
+			return nil, fmt.Errorf("no gas price set for denom %s", denom)
+		}
+		return computeFees(c.GasPrice, gas), nil
+	}
+
+	gasPrice, err := c.GasPriceProvider.GasPrice(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting gas price: %s", err)
+	}
+
+	return computeFees(gasPrice, gas), nil
+}
+
+// GetAffordableFeesCtx returns the fees that should be paid to perform a transaction with the given gas,
+// using the first fee denom the given payer has enough balance of
+func (c *Client) GetAffordableFeesCtx(ctx context.Context, payer string, gas int64) (sdk.Coins, error) {
+	denoms := c.GetFeeDenoms()
+	for _, denom := range denoms {
+		fees, err := c.GetFeesInDenomCtx(ctx, gas, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		balance, err := c.GetBalanceCtx(ctx, payer, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		if balance.Amount.GTE(fees.AmountOf(denom)) {
+			return fees, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s does not have enough balance to pay fees in any of %s",
+		ErrInsufficientFunds, payer, strings.Join(denoms, ", "))
+}
+
+// GetBalanceCtx returns the balance of the given denom owned by the given address
+func (c *Client) GetBalanceCtx(ctx context.Context, address string, denom string) (sdk.Coin, error) {
+	res, err := withRetry(ctx, c.RetryPolicy, func() (*banktypes.QueryBalanceResponse, error) {
+		return c.BankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: address, Denom: denom})
+	})
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("error while querying balance: %w", mapGRPCError(err))
+	}
+
+	if res.Balance == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+	return *res.Balance, nil
+}
+
+// computeFees returns the fees that should be paid to perform a transaction with the given gas and gas price
+func computeFees(gasPrice sdk.DecCoin, gas int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.MulInt64(gas).Ceil().RoundInt()))
 }
 
 // GetAccount returns the details of the account having the given address reading it from the chain
 func (c *Client) GetAccount(address string) (authtypes.AccountI, error) {
-	res, err := c.AuthClient.Account(context.Background(), &authtypes.QueryAccountRequest{Address: address})
+	return c.GetAccountCtx(context.Background(), address)
+}
+
+// GetAccountCtx returns the details of the account having the given address reading it from the chain
+// using the given context. If the account does not exist, an error wrapping ErrAccountNotFound is returned.
+func (c *Client) GetAccountCtx(ctx context.Context, address string) (authtypes.AccountI, error) {
+	res, err := withRetry(ctx, c.RetryPolicy, func() (*authtypes.QueryAccountResponse, error) {
+		return c.AuthClient.Account(ctx, &authtypes.QueryAccountRequest{Address: address})
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, fmt.Errorf("%w: %s", ErrAccountNotFound, address)
+	}
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error while querying account: %w", mapGRPCError(err))
 	}
 
 	var account authtypes.AccountI
@@ -133,65 +447,263 @@ func (c *Client) GetAccount(address string) (authtypes.AccountI, error) {
 // SimulateTx simulates the execution of the given transaction, and returns the adjusted
 // amount of gas that should be used in order to properly execute it
 func (c *Client) SimulateTx(tx signing.Tx) (uint64, error) {
-	bytes, err := c.txEncoder(tx)
-	if err != nil {
-		return 0, err
-	}
+	return c.SimulateTxCtx(context.Background(), tx)
+}
 
-	simRes, err := c.TxClient.Simulate(context.Background(), &sdktx.SimulateRequest{
-		TxBytes: bytes,
-	})
+// SimulateTxCtx simulates the execution of the given transaction using the given context, and returns
+// the adjusted amount of gas that should be used in order to properly execute it.
+// If the simulation fails due to a known ABCI error, the returned error wraps the matching error of this
+// package (e.g. ErrInsufficientFunds).
+func (c *Client) SimulateTxCtx(ctx context.Context, tx signing.Tx) (uint64, error) {
+	res, err := c.SimulateCtx(ctx, tx)
 	if err != nil {
 		return 0, err
 	}
 
-	return uint64(math.Ceil(c.GasAdjustment * float64(simRes.GasInfo.GasUsed))), nil
+	return res.AdjustedGas, nil
 }
 
-// BroadcastTxAsync allows to broadcast a transaction containing the given messages using the sync method
-func (c *Client) BroadcastTxAsync(tx signing.Tx) (*sdk.TxResponse, error) {
+// Simulate simulates the execution of the given transaction, and returns the full result of the simulation
+func (c *Client) Simulate(tx signing.Tx) (*SimulationResult, error) {
+	return c.SimulateCtx(context.Background(), tx)
+}
+
+// SimulateCtx simulates the execution of the given transaction using the given context, and returns the full
+// result of the simulation, including the emitted events and the decoded message responses.
+// If the simulation fails due to a known ABCI error, the returned error wraps the matching error of this
+// package (e.g. ErrInsufficientFunds).
+func (c *Client) SimulateCtx(ctx context.Context, tx signing.Tx) (*SimulationResult, error) {
 	bytes, err := c.txEncoder(tx)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.RPCClient.BroadcastTxAsync(context.Background(), bytes)
+	simRes, err := withRetry(ctx, c.RetryPolicy, func() (*sdktx.SimulateResponse, error) {
+		return c.TxClient.Simulate(ctx, &sdktx.SimulateRequest{
+			TxBytes: bytes,
+		})
+	})
 	if err != nil {
-		return nil, err
+		return nil, mapGRPCError(err)
 	}
 
-	// Broadcast the transaction to a Tendermint node
-	return sdk.NewResponseFormatBroadcastTx(res), nil
+	result := &SimulationResult{
+		GasWanted:   simRes.GasInfo.GasWanted,
+		GasUsed:     simRes.GasInfo.GasUsed,
+		AdjustedGas: uint64(math.Ceil(c.GasAdjustment * float64(simRes.GasInfo.GasUsed))),
+		Result:      simRes.Result,
+	}
+
+	if simRes.Result != nil {
+		result.Events = simRes.Result.Events
+		result.Log = simRes.Result.Log
+		result.Logs, _ = sdk.ParseABCILogs(simRes.Result.Log)
+
+		result.MsgResponses = make([]sdktx.MsgResponse, len(simRes.Result.MsgResponses))
+		for i, msgResponse := range simRes.Result.MsgResponses {
+			var response sdktx.MsgResponse
+			if c.Codec.UnpackAny(msgResponse, &response) == nil {
+				result.MsgResponses[i] = response
+			}
+		}
+	}
+
+	return result, nil
 }
 
-// BroadcastTxSync allows to broadcast a transaction containing the given messages using the sync method
-func (c *Client) BroadcastTxSync(tx signing.Tx) (*sdk.TxResponse, error) {
+// BroadcastTxCtx allows to broadcast a transaction containing the given messages using the given mode and context
+func (c *Client) BroadcastTxCtx(ctx context.Context, tx signing.Tx, mode BroadcastMode) (*sdk.TxResponse, error) {
 	bytes, err := c.txEncoder(tx)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.RPCClient.BroadcastTxSync(context.Background(), bytes)
-	if err != nil {
-		return nil, err
+	return c.BroadcastTxBytes(ctx, bytes, mode)
+}
+
+// BroadcastTxBytes allows to broadcast the given encoded transaction using the given mode and context.
+// Broadcasts failing due to transient node errors are retried based on the RetryPolicy, but only if the transaction
+// could not have entered the mempool or if it can be confirmed that it has not been included inside a block.
+// If ReturnTxErrors is set and the transaction fails, the response is returned along with a *TxError.
+func (c *Client) BroadcastTxBytes(ctx context.Context, txBytes []byte, mode BroadcastMode) (*sdk.TxResponse, error) {
+	res, err := c.broadcastTxBytesWithRetry(ctx, txBytes, mode)
+	return c.checkTxResponse(res, err)
+}
+
+// broadcastTxBytesWithRetry broadcasts the given encoded transaction using the given mode and context,
+// retrying it based on the RetryPolicy
+func (c *Client) broadcastTxBytesWithRetry(ctx context.Context, txBytes []byte, mode BroadcastMode) (*sdk.TxResponse, error) {
+	txHash := fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash())
+
+	for attempt := uint(1); ; attempt++ {
+		res, err := c.broadcastTxBytes(ctx, txBytes, mode)
+
+		// If a previous attempt has reached the node, the transaction is already inside the mempool
+		if attempt > 1 && isTxInMempool(res, err) {
+			return &sdk.TxResponse{TxHash: txHash}, nil
+		}
+
+		retryable := c.RetryPolicy.IsRetryableResponse(res)
+		if err != nil && ctx.Err() == nil && c.RetryPolicy.IsRetryableError(err) && c.RetryPolicy.canRetry(attempt) {
+			// Make sure the transaction has not been included inside a block before broadcasting it again
+			txRes, found, queryErr := c.getTx(ctx, txHash)
+			if found {
+				return txRes, nil
+			}
+			retryable = queryErr == nil
+		}
+
+		if !retryable || !c.RetryPolicy.canRetry(attempt) {
+			return res, err
+		}
+
+		if waitErr := c.RetryPolicy.wait(ctx, attempt+1); waitErr != nil {
+			return res, err
+		}
 	}
+}
 
+// broadcastTxBytes broadcasts the given encoded transaction using the given mode and context
+func (c *Client) broadcastTxBytes(ctx context.Context, txBytes []byte, mode BroadcastMode) (*sdk.TxResponse, error) {
 	// Broadcast the transaction to a Tendermint node
-	return sdk.NewResponseFormatBroadcastTx(res), nil
+	switch mode {
+	case BroadcastAsync:
+		res, err := c.RPCClient.BroadcastTxAsync(ctx, txBytes)
+		if err != nil {
+			return nil, err
+		}
+		return sdk.NewResponseFormatBroadcastTx(res), nil
+
+	case BroadcastSync:
+		res, err := c.RPCClient.BroadcastTxSync(ctx, txBytes)
+		if err != nil {
+			return nil, err
+		}
+		return sdk.NewResponseFormatBroadcastTx(res), nil
+
+	case BroadcastCommit:
+		res, err := c.RPCClient.BroadcastTxCommit(ctx, txBytes)
+		if err != nil {
+			return nil, err
+		}
+		return NewResponseFormatBroadcastTxCommit(res), nil
+
+	default:
+		return nil, fmt.Errorf("invalid broadcast mode: %s", mode)
+	}
 }
 
-// BroadcastTxCommit allows to broadcast a transaction containing the given messages using the commit method
-func (c *Client) BroadcastTxCommit(tx signing.Tx) (*sdk.TxResponse, error) {
-	bytes, err := c.txEncoder(tx)
+// checkTxResponse returns a *TxError along with the given response if ReturnTxErrors is set and
+// the transaction has failed
+func (c *Client) checkTxResponse(res *sdk.TxResponse, err error) (*sdk.TxResponse, error) {
+	if err != nil || !c.ReturnTxErrors {
+		return res, err
+	}
+	return res, ErrorFromTxResponse(res)
+}
+
+// getTx returns the transaction having the given hash, and whether it has been found.
+// An error is returned if it cannot be determined whether the transaction has been included inside a block.
+func (c *Client) getTx(ctx context.Context, hash string) (*sdk.TxResponse, bool, error) {
+	res, err := c.TxClient.GetTx(ctx, &sdktx.GetTxRequest{Hash: hash})
+	if status.Code(err) == codes.NotFound {
+		return nil, false, nil
+	}
 	if err != nil {
-		return nil, err
+		return nil, false, mapGRPCError(err)
 	}
+	return res.TxResponse, true, nil
+}
 
-	res, err := c.RPCClient.BroadcastTxCommit(context.Background(), bytes)
+// isTxInMempool tells whether the given broadcast result tells that the transaction is already inside the mempool
+func isTxInMempool(res *sdk.TxResponse, err error) bool {
 	if err != nil {
-		return nil, err
+		return strings.Contains(err.Error(), mempool.ErrTxInCache.Error())
 	}
+	return res.Codespace == sdkerrors.ErrTxInMempoolCache.Codespace() && res.Code == sdkerrors.ErrTxInMempoolCache.ABCICode()
+}
 
-	// Broadcast the transaction to a Tendermint node
-	return NewResponseFormatBroadcastTxCommit(res), nil
+// BroadcastTxAsync allows to broadcast a transaction containing the given messages using the async method
+func (c *Client) BroadcastTxAsync(tx signing.Tx) (*sdk.TxResponse, error) {
+	return c.BroadcastTxAsyncCtx(context.Background(), tx)
+}
+
+// BroadcastTxAsyncCtx allows to broadcast a transaction containing the given messages using the async method
+// and the given context
+func (c *Client) BroadcastTxAsyncCtx(ctx context.Context, tx signing.Tx) (*sdk.TxResponse, error) {
+	return c.BroadcastTxCtx(ctx, tx, BroadcastAsync)
+}
+
+// BroadcastTxSync allows to broadcast a transaction containing the given messages using the sync method
+func (c *Client) BroadcastTxSync(tx signing.Tx) (*sdk.TxResponse, error) {
+	return c.BroadcastTxSyncCtx(context.Background(), tx)
+}
+
+// BroadcastTxSyncCtx allows to broadcast a transaction containing the given messages using the sync method
+// and the given context
+func (c *Client) BroadcastTxSyncCtx(ctx context.Context, tx signing.Tx) (*sdk.TxResponse, error) {
+	return c.BroadcastTxCtx(ctx, tx, BroadcastSync)
+}
+
+// BroadcastTxCommit allows to broadcast a transaction containing the given messages using the commit method
+func (c *Client) BroadcastTxCommit(tx signing.Tx) (*sdk.TxResponse, error) {
+	return c.BroadcastTxCommitCtx(context.Background(), tx)
+}
+
+// BroadcastTxCommitCtx allows to broadcast a transaction containing the given messages using the commit method
+// and the given context
+func (c *Client) BroadcastTxCommitCtx(ctx context.Context, tx signing.Tx) (*sdk.TxResponse, error) {
+	return c.BroadcastTxCtx(ctx, tx, BroadcastCommit)
+}
+
+// WaitForTx waits until the transaction having the given hash is included inside a block, and then returns it.
+// The chain is polled every TxPollInterval until the given context is done, in which case an error wrapping
+// ErrTxNotFound is returned.
+// If ReturnTxErrors is set and the transaction has failed, the response is returned along with a *TxError.
+func (c *Client) WaitForTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	return c.WaitForTxUntilHeight(ctx, hash, 0)
+}
+
+// WaitForTxUntilHeight waits until the transaction having the given hash is included inside a block, like WaitForTx.
+// If the given timeout height is not zero, ErrTxTimeoutHeight is returned as soon as the chain goes past it
+// without including the transaction, since it cannot be included anymore. Errors while reading the latest
+// height do not stop the wait.
+func (c *Client) WaitForTxUntilHeight(ctx context.Context, hash string, timeoutHeight uint64) (*sdk.TxResponse, error) {
+	ticker := time.NewTicker(c.TxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Read the latest height before searching the transaction, so that a transaction included
+		// between the two requests is never considered expired
+		var latestHeight int64
+		if timeoutHeight > 0 {
+			// The height is only used to detect the expiration of the transaction, so if it cannot be read
+			// the transaction is searched anyway and the expiration is checked again during the next round
+			height, err := c.GetLatestHeightCtx(ctx)
+			if err == nil {
+				latestHeight = height
+			}
+		}
+
+		res, err := withRetry(ctx, c.RetryPolicy, func() (*sdktx.GetTxResponse, error) {
+			return c.TxClient.GetTx(ctx, &sdktx.GetTxRequest{Hash: hash})
+		})
+		if err == nil {
+			return c.checkTxResponse(res.TxResponse, nil)
+		}
+
+		if ctx.Err() == nil && status.Code(err) != codes.NotFound {
+			return nil, fmt.Errorf("error while getting tx %s: %w", hash, mapGRPCError(err))
+		}
+
+		if timeoutHeight > 0 && latestHeight > int64(timeoutHeight) {
+			return nil, fmt.Errorf("%w: %s not included before height %d", ErrTxTimeoutHeight, hash, timeoutHeight)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %s: %w", ErrTxNotFound, hash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
 }
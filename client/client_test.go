@@ -0,0 +1,676 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/desmos-labs/cosmos-go-wallet/client"
+	"github.com/desmos-labs/cosmos-go-wallet/testutils"
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+)
+
+func TestClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientTestSuite))
+}
+
+type ClientTestSuite struct {
+	suite.Suite
+
+	client *client.Client
+}
+
+func (suite *ClientTestSuite) SetupTest() {
+	chainCfg := types.ChainConfig{
+		Bech32Prefix:  "desmos",
+		RPCAddr:       "http://localhost:26657",
+		GRPCAddr:      "http://localhost:9090",
+		GasPrice:      "0.01udaric",
+		GasAdjustment: 1.5,
+	}
+
+	encodingCfg := testutils.MakeTestEncodingConfig()
+
+	c, err := client.NewClient(&chainCfg, encodingCfg.Codec)
+	suite.Require().NoError(err)
+	c.TxPollInterval = 10 * time.Millisecond
+	c.RetryPolicy.InitialBackoff = time.Millisecond
+	suite.client = c
+}
+
+func (suite *ClientTestSuite) TestWaitForTx() {
+	testCases := []struct {
+		name      string
+		txClient  *testutils.MockTxClient
+		shouldErr bool
+		check     func(res *sdk.TxResponse, err error)
+	}{
+		{
+			name:     "transaction found after some polls",
+			txClient: &testutils.MockTxClient{FoundAt: 3},
+			check: func(res *sdk.TxResponse, err error) {
+				suite.Require().Equal("HASH", res.TxHash)
+				suite.Require().Equal(int64(10), res.Height)
+			},
+		},
+		{
+			name:      "transaction never found returns ErrTxNotFound",
+			txClient:  &testutils.MockTxClient{},
+			shouldErr: true,
+			check: func(res *sdk.TxResponse, err error) {
+				suite.Require().ErrorIs(err, client.ErrTxNotFound)
+				suite.Require().ErrorIs(err, context.DeadlineExceeded)
+			},
+		},
+		{
+			name:      "query error is returned",
+			txClient:  &testutils.MockTxClient{GetTxErr: status.Error(codes.Internal, "internal error")},
+			shouldErr: true,
+			check: func(res *sdk.TxResponse, err error) {
+				suite.Require().False(errors.Is(err, client.ErrTxNotFound))
+				suite.Require().Equal(1, suite.client.TxClient.(*testutils.MockTxClient).Calls)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.client.TxClient = tc.txClient
+
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			res, err := suite.client.WaitForTx(ctx, "HASH")
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			if tc.check != nil {
+				tc.check(res, err)
+			}
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestWaitForTxUntilHeight() {
+	testCases := []struct {
+		name          string
+		txClient      *testutils.MockTxClient
+		timeoutHeight uint64
+		statusErr     error
+		shouldErr     bool
+		check         func(res *sdk.TxResponse, err error)
+	}{
+		{
+			name:          "transaction found before the timeout height",
+			txClient:      &testutils.MockTxClient{FoundAt: 3},
+			timeoutHeight: 100,
+			check: func(res *sdk.TxResponse, err error) {
+				suite.Require().Equal("HASH", res.TxHash)
+			},
+		},
+		{
+			name:          "transaction not found after the timeout height returns ErrTxTimeoutHeight",
+			txClient:      &testutils.MockTxClient{},
+			timeoutHeight: 12,
+			shouldErr:     true,
+			check: func(res *sdk.TxResponse, err error) {
+				suite.Require().ErrorIs(err, client.ErrTxTimeoutHeight)
+				suite.Require().Equal(3, suite.client.TxClient.(*testutils.MockTxClient).Calls)
+			},
+		},
+		{
+			name:          "latest height errors do not stop the wait",
+			txClient:      &testutils.MockTxClient{FoundAt: 3},
+			timeoutHeight: 12,
+			statusErr:     fmt.Errorf("connection reset"),
+			check: func(res *sdk.TxResponse, err error) {
+				suite.Require().Equal("HASH", res.TxHash)
+			},
+		},
+		{
+			name:          "transaction not found while the latest height cannot be read returns ErrTxNotFound",
+			txClient:      &testutils.MockTxClient{},
+			timeoutHeight: 12,
+			statusErr:     fmt.Errorf("connection reset"),
+			shouldErr:     true,
+			check: func(res *sdk.TxResponse, err error) {
+				suite.Require().ErrorIs(err, client.ErrTxNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.client.TxClient = tc.txClient
+			suite.client.RPCClient = &testutils.MockRPCClient{Height: 11, HeightIncrement: 1, StatusErr: tc.statusErr}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			res, err := suite.client.WaitForTxUntilHeight(ctx, "HASH", tc.timeoutHeight)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			if tc.check != nil {
+				tc.check(res, err)
+			}
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestRetryPolicy() {
+	testCases := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{
+			name:      "unavailable gRPC error is retryable",
+			err:       status.Error(codes.Unavailable, "connection refused"),
+			retryable: true,
+		},
+		{
+			name:      "not found gRPC error is not retryable",
+			err:       status.Error(codes.NotFound, "account not found"),
+			retryable: false,
+		},
+		{
+			name:      "RPC connection error is retryable",
+			err:       fmt.Errorf("post failed: %w", &url.Error{Op: "Post", URL: "http://localhost:26657", Err: errors.New("connection refused")}),
+			retryable: true,
+		},
+		{
+			name:      "bad gateway error is retryable",
+			err:       errors.New("error in json rpc client, with http response metadata: (Status: 502 Bad Gateway, Protocol HTTP/1.1). error unmarshalling: invalid character '<'"),
+			retryable: true,
+		},
+		{
+			name:      "RPC error returned by the node is not retryable",
+			err:       fmt.Errorf("response error: %w", &rpctypes.RPCError{Code: -32603, Message: "Internal error", Data: "tx already exists in cache"}),
+			retryable: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.retryable, client.DefaultRetryPolicy().IsRetryableError(tc.err))
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestGetAccountRetry() {
+	testCases := []struct {
+		name          string
+		authClient    *testutils.MockAuthClient
+		shouldErr     bool
+		expectedCalls int
+	}{
+		{
+			name: "transient errors are retried",
+			authClient: &testutils.MockAuthClient{Errors: []error{
+				status.Error(codes.Unavailable, "connection refused"),
+				status.Error(codes.Unavailable, "connection refused"),
+			}},
+			shouldErr:     false,
+			expectedCalls: 3,
+		},
+		{
+			name: "errors are returned after the maximum number of attempts",
+			authClient: &testutils.MockAuthClient{Errors: []error{
+				status.Error(codes.Unavailable, "connection refused"),
+				status.Error(codes.Unavailable, "connection refused"),
+				status.Error(codes.Unavailable, "connection refused"),
+			}},
+			shouldErr:     true,
+			expectedCalls: 3,
+		},
+		{
+			name: "non transient errors are not retried",
+			authClient: &testutils.MockAuthClient{Errors: []error{
+				status.Error(codes.NotFound, "account not found"),
+			}},
+			shouldErr:     true,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.client.AuthClient = tc.authClient
+
+			_, err := suite.client.GetAccountCtx(context.Background(), "desmos1address")
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(tc.expectedCalls, tc.authClient.Calls)
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestBroadcastTxBytesRetry() {
+	connectionErr := &url.Error{Op: "Post", URL: "http://localhost:26657", Err: errors.New("connection reset")}
+
+	testCases := []struct {
+		name          string
+		rpcClient     *testutils.MockRPCClient
+		txClient      *testutils.MockTxClient
+		shouldErr     bool
+		expectedCalls int
+		check         func(res *sdk.TxResponse)
+	}{
+		{
+			name: "connection error is retried when the transaction is not found",
+			rpcClient: &testutils.MockRPCClient{
+				Results: []*coretypes.ResultBroadcastTx{nil, {Hash: []byte{0x01}}},
+				Errors:  []error{connectionErr, nil},
+			},
+			txClient:      &testutils.MockTxClient{},
+			expectedCalls: 2,
+		},
+		{
+			name: "connection error is not retried when the transaction has been included",
+			rpcClient: &testutils.MockRPCClient{
+				Results: []*coretypes.ResultBroadcastTx{nil},
+				Errors:  []error{connectionErr},
+			},
+			txClient:      &testutils.MockTxClient{FoundAt: 1},
+			expectedCalls: 1,
+			check: func(res *sdk.TxResponse) {
+				suite.Require().Equal(int64(10), res.Height)
+			},
+		},
+		{
+			name: "connection error is not retried when the transaction status is unknown",
+			rpcClient: &testutils.MockRPCClient{
+				Results: []*coretypes.ResultBroadcastTx{nil},
+				Errors:  []error{connectionErr},
+			},
+			txClient:      &testutils.MockTxClient{GetTxErr: status.Error(codes.Unavailable, "connection refused")},
+			shouldErr:     true,
+			expectedCalls: 1,
+		},
+		{
+			name: "transaction already inside the mempool is considered broadcast",
+			rpcClient: &testutils.MockRPCClient{
+				Results: []*coretypes.ResultBroadcastTx{nil, nil},
+				Errors: []error{
+					connectionErr,
+					fmt.Errorf("response error: %w", &rpctypes.RPCError{Code: -32603, Message: "Internal error", Data: "tx already exists in cache"}),
+				},
+			},
+			txClient:      &testutils.MockTxClient{},
+			expectedCalls: 2,
+			check: func(res *sdk.TxResponse) {
+				suite.Require().Equal(uint32(0), res.Code)
+				suite.Require().NotEmpty(res.TxHash)
+			},
+		},
+		{
+			name: "retryable CheckTx error is retried",
+			rpcClient: &testutils.MockRPCClient{
+				Results: []*coretypes.ResultBroadcastTx{
+					{Codespace: sdkerrors.ErrMempoolIsFull.Codespace(), Code: sdkerrors.ErrMempoolIsFull.ABCICode()},
+					{Hash: []byte{0x01}},
+				},
+				Errors: []error{nil, nil},
+			},
+			txClient:      &testutils.MockTxClient{},
+			expectedCalls: 2,
+		},
+		{
+			name: "non retryable CheckTx error is not retried",
+			rpcClient: &testutils.MockRPCClient{
+				Results: []*coretypes.ResultBroadcastTx{
+					{Codespace: sdkerrors.ErrInsufficientFee.Codespace(), Code: sdkerrors.ErrInsufficientFee.ABCICode()},
+				},
+				Errors: []error{nil},
+			},
+			txClient:      &testutils.MockTxClient{},
+			expectedCalls: 1,
+			check: func(res *sdk.TxResponse) {
+				suite.Require().Equal(sdkerrors.ErrInsufficientFee.ABCICode(), res.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.client.RPCClient = tc.rpcClient
+			suite.client.TxClient = tc.txClient
+
+			res, err := suite.client.BroadcastTxBytes(context.Background(), []byte("tx"), client.BroadcastSync)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(tc.expectedCalls, tc.rpcClient.Calls)
+
+			if tc.check != nil {
+				tc.check(res)
+			}
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestGetChainID() {
+	testCases := []struct {
+		name                string
+		configChainID       string
+		run                 func() (string, error)
+		expectedChainID     string
+		expectedStatusCalls int
+	}{
+		{
+			name: "chain id is read from the node only once",
+			run: func() (string, error) {
+				_, err := suite.client.GetChainID()
+				suite.Require().NoError(err)
+				return suite.client.GetChainID()
+			},
+			expectedChainID:     "morpheus-apollo-3",
+			expectedStatusCalls: 1,
+		},
+		{
+			name:          "chain id set inside the config is used without querying the node",
+			configChainID: "desmos-mainnet",
+			run: func() (string, error) {
+				return suite.client.GetChainID()
+			},
+			expectedChainID:     "desmos-mainnet",
+			expectedStatusCalls: 0,
+		},
+		{
+			name: "refreshing the node info reads it again",
+			run: func() (string, error) {
+				_, err := suite.client.GetChainID()
+				suite.Require().NoError(err)
+
+				suite.client.RPCClient.(*testutils.MockRPCClient).Network = "morpheus-apollo-4"
+				nodeInfo, err := suite.client.RefreshNodeInfo()
+				suite.Require().NoError(err)
+				suite.Require().Equal("5.2.0", nodeInfo.AppVersion)
+
+				return suite.client.GetChainID()
+			},
+			expectedChainID:     "morpheus-apollo-4",
+			expectedStatusCalls: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			chainCfg := types.ChainConfig{
+				ChainID:      tc.configChainID,
+				Bech32Prefix: "desmos",
+				RPCAddr:      "http://localhost:26657",
+				GRPCAddr:     "http://localhost:9090",
+				GasPrice:     "0.01udaric",
+			}
+
+			c, err := client.NewClient(&chainCfg, testutils.MakeTestEncodingConfig().Codec)
+			suite.Require().NoError(err)
+
+			rpcClient := &testutils.MockRPCClient{Network: "morpheus-apollo-3"}
+			c.RPCClient = rpcClient
+			suite.client = c
+
+			chainID, err := tc.run()
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedChainID, chainID)
+			suite.Require().Equal(tc.expectedStatusCalls, rpcClient.StatusCalls)
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestErrorFromTxResponse() {
+	testCases := []struct {
+		name     string
+		res      *sdk.TxResponse
+		expected error
+	}{
+		{
+			name: "successful transaction returns no error",
+			res:  &sdk.TxResponse{Code: 0},
+		},
+		{
+			name:     "insufficient funds is mapped properly",
+			res:      &sdk.TxResponse{Codespace: "sdk", Code: 5, RawLog: "10udaric is smaller than 20udaric: insufficient funds"},
+			expected: client.ErrInsufficientFunds,
+		},
+		{
+			name:     "wrong sequence is mapped properly",
+			res:      &sdk.TxResponse{Codespace: "sdk", Code: 32, RawLog: "account sequence mismatch, expected 5, got 4: incorrect account sequence"},
+			expected: client.ErrWrongSequence,
+		},
+		{
+			name:     "insufficient fee is mapped properly",
+			res:      &sdk.TxResponse{Codespace: "sdk", Code: 13, RawLog: "insufficient fees; got: 10udaric required: 20udaric: insufficient fee"},
+			expected: client.ErrInsufficientFee,
+		},
+		{
+			name:     "out of gas is mapped properly",
+			res:      &sdk.TxResponse{Codespace: "sdk", Code: 11, RawLog: "out of gas in location: WriteFlat; gasWanted: 100, gasUsed: 200: out of gas"},
+			expected: client.ErrOutOfGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := client.ErrorFromTxResponse(tc.res)
+			if tc.expected == nil {
+				suite.Require().NoError(err)
+				return
+			}
+
+			suite.Require().ErrorIs(err, tc.expected)
+
+			// Make sure the error can also be checked against the registered SDK error
+			suite.Require().ErrorIs(err, errorsmod.ABCIError(tc.res.Codespace, tc.res.Code, ""))
+
+			var txErr *client.TxError
+			suite.Require().True(errors.As(err, &txErr))
+			suite.Require().Equal(tc.res.RawLog, txErr.RawLog)
+		})
+	}
+
+	suite.Run("unknown error is returned without being mapped", func() {
+		err := client.ErrorFromTxResponse(&sdk.TxResponse{Codespace: "profiles", Code: 2, RawLog: "invalid dtag"})
+		suite.Require().Error(err)
+		suite.Require().False(errors.Is(err, client.ErrInsufficientFunds))
+	})
+}
+
+func (suite *ClientTestSuite) TestQueryErrors() {
+	testCases := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{
+			name:     "error is mapped using the ABCI code",
+			err:      sdkerrors.ErrUnauthorized.Wrap("pubkey on account is not set"),
+			expected: client.ErrUnauthorized,
+		},
+		{
+			name:     "error is mapped using the ABCI code received from the node",
+			err:      status.Error(codes.InvalidArgument, "codespace sdk code 5: insufficient funds: 10udaric is smaller than 20udaric"),
+			expected: client.ErrInsufficientFunds,
+		},
+		{
+			name:     "error of a different codespace is not mapped using its description",
+			err:      status.Error(codes.InvalidArgument, "codespace bank code 5: insufficient funds"),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.client.AuthClient = &testutils.MockAuthClient{Errors: []error{tc.err}}
+			suite.client.TxClient = &testutils.MockTxClient{GetTxErr: tc.err}
+
+			_, accountErr := suite.client.GetAccountCtx(context.Background(), "desmos1address")
+			suite.Require().Error(accountErr)
+
+			_, txErr := suite.client.WaitForTx(context.Background(), "HASH")
+			suite.Require().Error(txErr)
+
+			for _, err := range []error{accountErr, txErr} {
+				for _, mapped := range []error{client.ErrUnauthorized, client.ErrInsufficientFunds} {
+					suite.Require().Equal(mapped == tc.expected, errors.Is(err, mapped), err.Error())
+				}
+			}
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestSimulateTxErrors() {
+	testCases := []struct {
+		name        string
+		simulateErr error
+		expected    error
+	}{
+		{
+			name:        "insufficient funds is mapped properly",
+			simulateErr: status.Error(codes.Unknown, "spendable balance 10udaric is smaller than 20udaric: insufficient funds With gas wanted: '0' and gas used: '50000' "),
+			expected:    client.ErrInsufficientFunds,
+		},
+		{
+			name:        "wrong sequence is mapped properly",
+			simulateErr: status.Error(codes.Unknown, "account sequence mismatch, expected 5, got 4: incorrect account sequence"),
+			expected:    client.ErrWrongSequence,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.client.TxClient = &testutils.MockTxClient{SimulateErr: tc.simulateErr}
+
+			tx := testutils.MakeTestEncodingConfig().TxConfig.NewTxBuilder().GetTx()
+			_, err := suite.client.SimulateTxCtx(context.Background(), tx)
+			suite.Require().ErrorIs(err, tc.expected)
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestReturnTxErrors() {
+	testCases := []struct {
+		name           string
+		returnTxErrors bool
+		shouldErr      bool
+	}{
+		{
+			name:           "failed transaction returns no error by default",
+			returnTxErrors: false,
+			shouldErr:      false,
+		},
+		{
+			name:           "failed transaction returns TxError when enabled",
+			returnTxErrors: true,
+			shouldErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.client.ReturnTxErrors = tc.returnTxErrors
+			suite.client.RPCClient = &testutils.MockRPCClient{
+				Results: []*coretypes.ResultBroadcastTx{{
+					Codespace: sdkerrors.ErrInsufficientFunds.Codespace(),
+					Code:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+					Log:       "10udaric is smaller than 20udaric: insufficient funds",
+					Hash:      []byte{0x01},
+				}},
+				Errors: []error{nil},
+			}
+
+			res, err := suite.client.BroadcastTxBytes(context.Background(), []byte("tx"), client.BroadcastSync)
+			suite.Require().NotNil(res)
+			suite.Require().Equal(sdkerrors.ErrInsufficientFunds.ABCICode(), res.Code)
+
+			if !tc.shouldErr {
+				suite.Require().NoError(err)
+				return
+			}
+
+			var txErr *client.TxError
+			suite.Require().True(errors.As(err, &txErr))
+			suite.Require().Equal("01", txErr.TxHash)
+			suite.Require().ErrorIs(err, client.ErrInsufficientFunds)
+			suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestSimulate() {
+	msgResponse, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+	suite.Require().NoError(err)
+
+	unknownResponse := &codectypes.Any{TypeUrl: "/unknown.v1.MsgUnknownResponse"}
+
+	suite.client.TxClient = &testutils.MockTxClient{SimulateRes: &sdktx.SimulateResponse{
+		GasInfo: &sdk.GasInfo{GasWanted: 200_000, GasUsed: 100_000},
+		Result: &sdk.Result{
+			Log: `[{"msg_index":0,"events":[{"type":"transfer","attributes":[{"key":"amount","value":"10udaric"}]}]}]`,
+			Events: []abci.Event{
+				{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "10udaric"}}},
+			},
+			MsgResponses: []*codectypes.Any{msgResponse, unknownResponse},
+		},
+	}}
+
+	tx := testutils.MakeTestEncodingConfig().TxConfig.NewTxBuilder().GetTx()
+	res, err := suite.client.SimulateCtx(context.Background(), tx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(uint64(200_000), res.GasWanted)
+	suite.Require().Equal(uint64(100_000), res.GasUsed)
+	suite.Require().Equal(uint64(150_000), res.AdjustedGas)
+	suite.Require().Len(res.Events, 1)
+	suite.Require().Len(res.Logs, 1)
+
+	suite.Require().Len(res.MsgResponses, 2)
+	suite.Require().IsType(&banktypes.MsgSendResponse{}, res.MsgResponses[0])
+	suite.Require().Nil(res.MsgResponses[1])
+}
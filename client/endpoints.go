@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// EndpointStatus contains the latest known status of a node endpoint
+type EndpointStatus struct {
+	Address string
+
+	// Available tells whether the endpoint could be reached during the latest call or health check
+	Available bool
+
+	// CatchingUp tells whether the node was still syncing during the latest health check
+	CatchingUp bool
+
+	// Height is the latest block height of the node as seen during the latest health check
+	Height int64
+}
+
+// endpoint represents a single node endpoint along with its latest known status
+type endpoint[T any] struct {
+	index  int
+	client T
+	status EndpointStatus
+}
+
+// endpointPool contains a set of endpoints, and allows to iterate over them by preferring the healthy ones
+type endpointPool[T any] struct {
+	mu        sync.RWMutex
+	endpoints []*endpoint[T]
+}
+
+// newEndpointPool returns a new endpointPool containing the given clients.
+// All the endpoints are considered available until a call or a health check fails.
+func newEndpointPool[T any](addresses []string, clients []T) *endpointPool[T] {
+	endpoints := make([]*endpoint[T], len(clients))
+	for i, client := range clients {
+		endpoints[i] = &endpoint[T]{
+			index:  i,
+			client: client,
+			status: EndpointStatus{Address: addresses[i], Available: true},
+		}
+	}
+	return &endpointPool[T]{endpoints: endpoints}
+}
+
+// primary returns the client of the first configured endpoint
+func (p *endpointPool[T]) primary() T {
+	return p.endpoints[0].client
+}
+
+// ordered returns the endpoints sorted by preference.
+// Available endpoints come first, then the ones that are caught up, then the ones having the highest height.
+// Endpoints that are equally good are returned in the configured order.
+func (p *endpointPool[T]) ordered() []*endpoint[T] {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	endpoints := make([]*endpoint[T], len(p.endpoints))
+	copy(endpoints, p.endpoints)
+
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		first, second := endpoints[i].status, endpoints[j].status
+		if first.Available != second.Available {
+			return first.Available
+		}
+		if first.CatchingUp != second.CatchingUp {
+			return !first.CatchingUp
+		}
+		if first.Height != second.Height {
+			return first.Height > second.Height
+		}
+		return endpoints[i].index < endpoints[j].index
+	})
+
+	return endpoints
+}
+
+// setAvailable sets whether the given endpoint can be reached
+func (p *endpointPool[T]) setAvailable(e *endpoint[T], available bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	e.status.Available = available
+}
+
+// setStatus sets the status of the given endpoint as seen during a health check
+func (p *endpointPool[T]) setStatus(e *endpoint[T], catchingUp bool, height int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	e.status.Available = true
+	e.status.CatchingUp = catchingUp
+	e.status.Height = height
+}
+
+// statuses returns the status of all the endpoints in the configured order
+func (p *endpointPool[T]) statuses() []EndpointStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	statuses := make([]EndpointStatus, len(p.endpoints))
+	for i, e := range p.endpoints {
+		statuses[i] = e.status
+	}
+	return statuses
+}
+
+// checkHealth updates the status of all the endpoints concurrently using the given function, which should
+// return whether the node is catching up and its latest height
+func (p *endpointPool[T]) checkHealth(ctx context.Context, check func(ctx context.Context, client T) (bool, int64, error)) {
+	var wg sync.WaitGroup
+	for _, e := range p.endpoints {
+		wg.Add(1)
+		go func(e *endpoint[T]) {
+			defer wg.Done()
+
+			catchingUp, height, err := check(ctx, e.client)
+			if err != nil {
+				if ctx.Err() == nil {
+					p.setAvailable(e, false)
+				}
+				return
+			}
+
+			p.setStatus(e, catchingUp, height)
+		}(e)
+	}
+	wg.Wait()
+}
+
+// execute calls the given function using the clients of the endpoints in order of preference, moving to the
+// next endpoint each time the returned error is a connection error. Endpoints returning a connection error
+// are marked as unavailable until they are reached again.
+func execute[T any, R any](ctx context.Context, p *endpointPool[T], isConnectionError func(error) bool, fn func(client T) (R, error)) (R, error) {
+	var res R
+	var err error
+	for _, e := range p.ordered() {
+		res, err = fn(e.client)
+		if err != nil && isConnectionError(err) {
+			if ctx.Err() != nil {
+				return res, err
+			}
+
+			p.setAvailable(e, false)
+			continue
+		}
+
+		p.setAvailable(e, true)
+		return res, err
+	}
+
+	return res, err
+}
+
+// executeOnce calls the given function using the client of the preferred endpoint only, without falling back to
+// the other ones. If the returned error is a connection error, the endpoint is marked as unavailable so that
+// the next call uses a different endpoint.
+// It should be used for the calls that must not be repeated transparently (e.g. the broadcast of a transaction).
+func executeOnce[T any, R any](ctx context.Context, p *endpointPool[T], isConnectionError func(error) bool, fn func(client T) (R, error)) (R, error) {
+	e := p.ordered()[0]
+	res, err := fn(e.client)
+	if err != nil && isConnectionError(err) {
+		if ctx.Err() == nil {
+			p.setAvailable(e, false)
+		}
+		return res, err
+	}
+
+	p.setAvailable(e, true)
+	return res, err
+}
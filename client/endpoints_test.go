@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEndpointPoolTestSuite(t *testing.T) {
+	suite.Run(t, new(EndpointPoolTestSuite))
+}
+
+type EndpointPoolTestSuite struct {
+	suite.Suite
+
+	pool *endpointPool[string]
+}
+
+func (suite *EndpointPoolTestSuite) SetupTest() {
+	addresses := []string{"first", "second", "third"}
+	suite.pool = newEndpointPool(addresses, addresses)
+}
+
+func (suite *EndpointPoolTestSuite) orderedAddresses() []string {
+	var addresses []string
+	for _, e := range suite.pool.ordered() {
+		addresses = append(addresses, e.client)
+	}
+	return addresses
+}
+
+func (suite *EndpointPoolTestSuite) TestCheckHealth() {
+	testCases := []struct {
+		name     string
+		statuses map[string]EndpointStatus
+		expected []string
+	}{
+		{
+			name: "equally healthy endpoints keep the configured order",
+			statuses: map[string]EndpointStatus{
+				"first":  {Height: 10},
+				"second": {Height: 10},
+				"third":  {Height: 10},
+			},
+			expected: []string{"first", "second", "third"},
+		},
+		{
+			name: "endpoints at the highest height are preferred",
+			statuses: map[string]EndpointStatus{
+				"first":  {Height: 8},
+				"second": {Height: 9},
+				"third":  {Height: 10},
+			},
+			expected: []string{"third", "second", "first"},
+		},
+		{
+			name: "catching up endpoints are used after the caught up ones",
+			statuses: map[string]EndpointStatus{
+				"first":  {Height: 10, CatchingUp: true},
+				"second": {Height: 9},
+				"third":  {Height: 8},
+			},
+			expected: []string{"second", "third", "first"},
+		},
+		{
+			name: "unavailable endpoints are used as the last resort",
+			statuses: map[string]EndpointStatus{
+				"first": {Height: 10},
+				"third": {Height: 10, CatchingUp: true},
+			},
+			expected: []string{"first", "third", "second"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.pool.checkHealth(context.Background(), func(_ context.Context, client string) (bool, int64, error) {
+				status, found := tc.statuses[client]
+				if !found {
+					return false, 0, fmt.Errorf("connection refused")
+				}
+				return status.CatchingUp, status.Height, nil
+			})
+
+			suite.Require().Equal(tc.expected, suite.orderedAddresses())
+		})
+	}
+}
+
+func (suite *EndpointPoolTestSuite) TestExecute() {
+	testCases := []struct {
+		name      string
+		errors    map[string]error
+		shouldErr bool
+		expected  string
+		check     func()
+	}{
+		{
+			name:     "the first endpoint is used when available",
+			expected: "first",
+		},
+		{
+			name: "unavailable endpoints are skipped",
+			errors: map[string]error{
+				"first": status.Error(codes.Unavailable, "connection refused"),
+			},
+			expected: "second",
+			check: func() {
+				suite.Require().Equal([]string{"second", "third", "first"}, suite.orderedAddresses())
+			},
+		},
+		{
+			name: "non connection errors are returned without falling back",
+			errors: map[string]error{
+				"first": status.Error(codes.NotFound, "account not found"),
+			},
+			shouldErr: true,
+			check: func() {
+				suite.Require().Equal([]string{"first", "second", "third"}, suite.orderedAddresses())
+			},
+		},
+		{
+			name: "the last error is returned when no endpoint is available",
+			errors: map[string]error{
+				"first":  status.Error(codes.Unavailable, "connection refused"),
+				"second": status.Error(codes.Unavailable, "connection refused"),
+				"third":  status.Error(codes.Unavailable, "connection reset"),
+			},
+			shouldErr: true,
+			check: func() {
+				for _, endpoint := range suite.pool.statuses() {
+					suite.Require().False(endpoint.Available)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			res, err := execute(context.Background(), suite.pool, isGRPCConnectionError, func(client string) (string, error) {
+				if err, found := tc.errors[client]; found {
+					return "", err
+				}
+				return client, nil
+			})
+
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expected, res)
+			}
+
+			if tc.check != nil {
+				tc.check()
+			}
+		})
+	}
+}
+
+func (suite *EndpointPoolTestSuite) TestExecuteOnce() {
+	calls := 0
+	_, err := executeOnce(context.Background(), suite.pool, isGRPCConnectionError, func(client string) (string, error) {
+		calls++
+		return "", status.Error(codes.Unavailable, "connection reset")
+	})
+	suite.Require().Error(err)
+	suite.Require().Equal(1, calls)
+
+	// The endpoint is marked as unavailable so that the next call uses the following one
+	suite.Require().Equal([]string{"second", "third", "first"}, suite.orderedAddresses())
+
+	res, err := executeOnce(context.Background(), suite.pool, isGRPCConnectionError, func(client string) (string, error) {
+		return client, nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal("second", res)
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrTxNotFound is returned when a transaction has not been included inside a block yet
+	ErrTxNotFound = errors.New("transaction not found")
+
+	// ErrAccountNotFound is returned when an account does not exist on the chain yet (e.g. it has never received any token)
+	ErrAccountNotFound = errors.New("account not found")
+
+	// ErrTxRejected is returned when a transaction has been rejected by the node before being included inside a block
+	ErrTxRejected = errors.New("transaction rejected")
+
+	// ErrInsufficientFunds is returned when the account does not have enough funds to perform a transaction
+	ErrInsufficientFunds = errors.New("insufficient funds")
+
+	// ErrInsufficientFee is returned when the fees of a transaction are lower than the ones required by the node
+	ErrInsufficientFee = errors.New("insufficient fee")
+
+	// ErrOutOfGas is returned when a transaction runs out of gas
+	ErrOutOfGas = errors.New("out of gas")
+
+	// ErrWrongSequence is returned when a transaction has been signed using a wrong account sequence
+	ErrWrongSequence = errors.New("wrong sequence")
+
+	// ErrUnauthorized is returned when a transaction is not signed by the proper accounts
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrInvalidChainID is returned when a transaction has been signed using a wrong chain id
+	ErrInvalidChainID = errors.New("invalid chain id")
+
+	// ErrTxTimeoutHeight is returned when a transaction has not been included before its timeout height
+	ErrTxTimeoutHeight = errors.New("transaction timeout height reached")
+
+	// ErrTxTooLarge is returned when a transaction exceeds the maximum size allowed by the node
+	ErrTxTooLarge = errors.New("transaction too large")
+
+	// ErrMempoolFull is returned when the mempool of the node cannot accept any more transactions
+	ErrMempoolFull = errors.New("mempool is full")
+
+	// ErrTxInMempool is returned when a transaction is already inside the mempool of the node
+	ErrTxInMempool = errors.New("transaction already in mempool")
+
+	// ErrFeeLimitExceeded is returned when the gas or fees of a transaction exceed the configured limits
+	ErrFeeLimitExceeded = errors.New("fee limit exceeded")
+)
+
+var (
+	// abciErrors maps the ABCI errors to the errors exposed by this package
+	abciErrors = map[*errorsmod.Error]error{
+		sdkerrors.ErrUnknownAddress:    ErrAccountNotFound,
+		sdkerrors.ErrInsufficientFunds: ErrInsufficientFunds,
+		sdkerrors.ErrInsufficientFee:   ErrInsufficientFee,
+		sdkerrors.ErrOutOfGas:          ErrOutOfGas,
+		sdkerrors.ErrWrongSequence:     ErrWrongSequence,
+		sdkerrors.ErrUnauthorized:      ErrUnauthorized,
+		sdkerrors.ErrInvalidChainID:    ErrInvalidChainID,
+		sdkerrors.ErrTxTimeoutHeight:   ErrTxTimeoutHeight,
+		sdkerrors.ErrTxTooLarge:        ErrTxTooLarge,
+		sdkerrors.ErrMempoolIsFull:     ErrMempoolFull,
+		sdkerrors.ErrTxInMempoolCache:  ErrTxInMempool,
+	}
+)
+
+// TxError represents the failure of a transaction, either during CheckTx or during its execution inside a block
+type TxError struct {
+	TxHash    string
+	Height    int64
+	Codespace string
+	Code      uint32
+	RawLog    string
+}
+
+// NewTxError returns a new TxError built from the given transaction response
+func NewTxError(res *sdk.TxResponse) *TxError {
+	return &TxError{
+		TxHash:    res.TxHash,
+		Height:    res.Height,
+		Codespace: res.Codespace,
+		Code:      res.Code,
+		RawLog:    res.RawLog,
+	}
+}
+
+// Error implements error
+func (e *TxError) Error() string {
+	if e.TxHash == "" {
+		return fmt.Sprintf("transaction failed with code %d (codespace %s): %s", e.Code, e.Codespace, e.RawLog)
+	}
+	return fmt.Sprintf("transaction %s failed with code %d (codespace %s): %s", e.TxHash, e.Code, e.Codespace, e.RawLog)
+}
+
+// Unwrap returns the errors matching this transaction error, so that it can be checked using errors.Is against
+// both the errors exposed by this package (e.g. ErrInsufficientFunds) and the errors registered by the SDK
+// modules (e.g. sdkerrors.ErrInsufficientFunds)
+func (e *TxError) Unwrap() []error {
+	errs := []error{errorsmod.ABCIError(e.Codespace, e.Code, e.RawLog)}
+	for abciErr, err := range abciErrors {
+		if e.Codespace == abciErr.Codespace() && e.Code == abciErr.ABCICode() {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// ErrorFromTxResponse returns a *TxError representing the given transaction response, or nil if the
+// transaction has been executed successfully.
+// Known ABCI errors are mapped to the errors exposed by this package (e.g. ErrInsufficientFunds), so that
+// they can be checked using errors.Is.
+func ErrorFromTxResponse(res *sdk.TxResponse) error {
+	if res == nil || res.Code == 0 {
+		return nil
+	}
+	return NewTxError(res)
+}
+
+// grpcABCICodeRegex matches the ABCI codespace and code set by the SDK inside the message of the gRPC errors
+// returned by the query handlers (see errorsmod.Error#GRPCStatus)
+var grpcABCICodeRegex = regexp.MustCompile(`codespace (\S+) code (\d+)`)
+
+// mapGRPCError wraps the given gRPC error with the matching error exposed by this package, if any.
+// The ABCI error is identified by the codespace and code contained inside the gRPC status. Errors returned while
+// simulating transactions do not contain them, so in that case the ABCI error is identified by its description
+// (e.g. "account sequence mismatch, expected 5, got 4: incorrect account sequence") instead.
+func mapGRPCError(err error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	if matches := grpcABCICodeRegex.FindStringSubmatch(s.Message()); matches != nil {
+		code, parseErr := strconv.ParseUint(matches[2], 10, 32)
+		if parseErr != nil {
+			return err
+		}
+
+		for abciErr, mapped := range abciErrors {
+			if abciErr.Codespace() == matches[1] && abciErr.ABCICode() == uint32(code) {
+				return fmt.Errorf("%w: %w", mapped, err)
+			}
+		}
+		return err
+	}
+
+	for abciErr, mapped := range abciErrors {
+		if strings.HasSuffix(s.Message(), ": "+abciErr.Error()) || strings.Contains(s.Message(), ": "+abciErr.Error()+" ") {
+			return fmt.Errorf("%w: %w", mapped, err)
+		}
+	}
+
+	return err
+}
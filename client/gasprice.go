@@ -0,0 +1,365 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// GasPriceProvider represents an entity that is able to tell which gas price should be used to pay fees
+type GasPriceProvider interface {
+	// GasPrice returns the gas price that should be used to pay fees using the given denom
+	GasPrice(ctx context.Context, denom string) (sdk.DecCoin, error)
+}
+
+var (
+	_ GasPriceProvider = &StaticGasPriceProvider{}
+	_ GasPriceProvider = &FeeMarketGasPriceProvider{}
+	_ GasPriceProvider = &OsmosisGasPriceProvider{}
+	_ GasPriceProvider = &NodeGasPriceProvider{}
+	_ GasPriceProvider = &CachedGasPriceProvider{}
+)
+
+// StaticGasPriceProvider represents a GasPriceProvider that always returns the same gas prices
+type StaticGasPriceProvider struct {
+	gasPrices []sdk.DecCoin
+}
+
+// NewStaticGasPriceProvider returns a new StaticGasPriceProvider instance returning the given gas prices.
+// The first gas price is returned when no denom is specified.
+func NewStaticGasPriceProvider(gasPrices ...sdk.DecCoin) *StaticGasPriceProvider {
+	return &StaticGasPriceProvider{
+		gasPrices: gasPrices,
+	}
+}
+
+// GasPrice implements GasPriceProvider
+func (p *StaticGasPriceProvider) GasPrice(_ context.Context, denom string) (sdk.DecCoin, error) {
+	for _, gasPrice := range p.gasPrices {
+		if denom == "" || denom == gasPrice.Denom {
+			return gasPrice, nil
+		}
+	}
+	return sdk.DecCoin{}, fmt.Errorf("no gas price set for denom %s", denom)
+}
+
+// FeeMarketGasPriceProvider represents a GasPriceProvider that reads the gas price from the feemarket module
+// (https://github.com/skip-mev/feemarket)
+type FeeMarketGasPriceProvider struct {
+	conn grpc.ClientConnInterface
+}
+
+// NewFeeMarketGasPriceProvider returns a new FeeMarketGasPriceProvider instance querying the given connection
+func NewFeeMarketGasPriceProvider(conn grpc.ClientConnInterface) *FeeMarketGasPriceProvider {
+	return &FeeMarketGasPriceProvider{
+		conn: conn,
+	}
+}
+
+// GasPrice implements GasPriceProvider
+func (p *FeeMarketGasPriceProvider) GasPrice(ctx context.Context, denom string) (sdk.DecCoin, error) {
+	// GasPriceRequest { string denom = 1; }
+	req := protowire.AppendTag(nil, 1, protowire.BytesType)
+	req = protowire.AppendString(req, denom)
+
+	res, err := invokeRaw(ctx, p.conn, "/feemarket.feemarket.v1.Query/GasPrice", req)
+	if err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("error while querying feemarket gas price: %s", err)
+	}
+
+	// GasPriceResponse { cosmos.base.v1beta1.DecCoin price = 1; }
+	priceBz, err := readBytesField(res, 1)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return decodeDecCoin(priceBz)
+}
+
+// OsmosisGasPriceProvider represents a GasPriceProvider that reads the EIP-1559 base fee from the
+// Osmosis txfees module. Only the base fee denom is supported.
+type OsmosisGasPriceProvider struct {
+	conn grpc.ClientConnInterface
+}
+
+// NewOsmosisGasPriceProvider returns a new OsmosisGasPriceProvider instance querying the given connection
+func NewOsmosisGasPriceProvider(conn grpc.ClientConnInterface) *OsmosisGasPriceProvider {
+	return &OsmosisGasPriceProvider{
+		conn: conn,
+	}
+}
+
+// GasPrice implements GasPriceProvider
+func (p *OsmosisGasPriceProvider) GasPrice(ctx context.Context, denom string) (sdk.DecCoin, error) {
+	// QueryBaseDenomResponse { string base_denom = 1; }
+	res, err := invokeRaw(ctx, p.conn, "/osmosis.txfees.v1beta1.Query/BaseDenom", nil)
+	if err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("error while querying txfees base denom: %s", err)
+	}
+
+	baseDenomBz, err := readBytesField(res, 1)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	baseDenom := string(baseDenomBz)
+	if denom != "" && denom != baseDenom {
+		return sdk.DecCoin{}, fmt.Errorf("unsupported fee denom %s: only %s is supported", denom, baseDenom)
+	}
+
+	// QueryEipBaseFeeResponse { string base_fee = 1; }
+	res, err = invokeRaw(ctx, p.conn, "/osmosis.txfees.v1beta1.Query/GetEipBaseFee", nil)
+	if err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("error while querying txfees base fee: %s", err)
+	}
+
+	baseFeeBz, err := readBytesField(res, 1)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	baseFee, err := decodeDec(string(baseFeeBz))
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(baseDenom, baseFee), nil
+}
+
+// NodeGasPriceProvider represents a GasPriceProvider that reads the minimum gas prices set inside the node config
+type NodeGasPriceProvider struct {
+	client node.ServiceClient
+}
+
+// NewNodeGasPriceProvider returns a new NodeGasPriceProvider instance querying the given connection
+func NewNodeGasPriceProvider(conn grpc.ClientConnInterface) *NodeGasPriceProvider {
+	return &NodeGasPriceProvider{
+		client: node.NewServiceClient(conn),
+	}
+}
+
+// GasPrice implements GasPriceProvider.
+// If the denom is empty, the first minimum gas price set by the node is returned.
+// If the node does not set any minimum gas price for the given denom, a zero gas price is returned.
+func (p *NodeGasPriceProvider) GasPrice(ctx context.Context, denom string) (sdk.DecCoin, error) {
+	res, err := p.client.Config(ctx, &node.ConfigRequest{})
+	if err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("error while querying node config: %s", err)
+	}
+
+	minGasPrices, err := sdk.ParseDecCoins(res.MinimumGasPrice)
+	if err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("error while parsing node minimum gas prices: %s", err)
+	}
+
+	if denom == "" {
+		if minGasPrices.Empty() {
+			return sdk.DecCoin{}, fmt.Errorf("node does not set any minimum gas price")
+		}
+		return minGasPrices[0], nil
+	}
+
+	return sdk.NewDecCoinFromDec(denom, minGasPrices.AmountOf(denom)), nil
+}
+
+// CachedGasPriceProvider represents a GasPriceProvider that caches the gas prices returned by another provider,
+// reading them again only after the given interval has passed.
+// Once started, the cached gas prices are refreshed in the background so that GasPrice does not need to wait
+// for the other provider.
+type CachedGasPriceProvider struct {
+	provider GasPriceProvider
+	interval time.Duration
+
+	mu     sync.RWMutex
+	prices map[string]cachedGasPrice
+}
+
+// cachedGasPrice contains a gas price along with the time at which it has been read
+type cachedGasPrice struct {
+	price     sdk.DecCoin
+	updatedAt time.Time
+}
+
+// NewCachedGasPriceProvider returns a new CachedGasPriceProvider instance caching the gas prices returned by
+// the given provider for the given interval
+func NewCachedGasPriceProvider(provider GasPriceProvider, interval time.Duration) *CachedGasPriceProvider {
+	return &CachedGasPriceProvider{
+		provider: provider,
+		interval: interval,
+		prices:   map[string]cachedGasPrice{},
+	}
+}
+
+// GasPrice implements GasPriceProvider
+func (p *CachedGasPriceProvider) GasPrice(ctx context.Context, denom string) (sdk.DecCoin, error) {
+	p.mu.RLock()
+	cached, found := p.prices[denom]
+	p.mu.RUnlock()
+
+	if found && time.Since(cached.updatedAt) < p.interval {
+		return cached.price, nil
+	}
+
+	return p.refresh(ctx, denom)
+}
+
+// Start refreshes the cached gas prices every interval in the background, until the given context is done
+func (p *CachedGasPriceProvider) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.refreshAll(ctx)
+			}
+		}
+	}()
+}
+
+// refreshAll reads again all the cached gas prices.
+// If a gas price cannot be read, the cached one is kept until it expires.
+func (p *CachedGasPriceProvider) refreshAll(ctx context.Context) {
+	p.mu.RLock()
+	denoms := make([]string, 0, len(p.prices))
+	for denom := range p.prices {
+		denoms = append(denoms, denom)
+	}
+	p.mu.RUnlock()
+
+	for _, denom := range denoms {
+		_, _ = p.refresh(ctx, denom)
+	}
+}
+
+// refresh reads the gas price of the given denom from the other provider and caches it.
+// The lock is not held while reading the gas price, so that slow reads do not block the other callers.
+func (p *CachedGasPriceProvider) refresh(ctx context.Context, denom string) (sdk.DecCoin, error) {
+	price, err := p.provider.GasPrice(ctx, denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.prices[denom] = cachedGasPrice{price: price, updatedAt: time.Now()}
+	return price, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// rawCodec is a gRPC codec that sends and receives already encoded Protobuf messages, which allows to query
+// modules without depending on their Go types
+type rawCodec struct{}
+
+// Marshal implements encoding.Codec
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	bz, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid message type: %T", v)
+	}
+	return bz, nil
+}
+
+// Unmarshal implements encoding.Codec
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	bz, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("invalid message type: %T", v)
+	}
+	*bz = append((*bz)[:0], data...)
+	return nil
+}
+
+// Name implements encoding.Codec
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// invokeRaw calls the given gRPC method sending the given encoded request, and returns the encoded response
+func invokeRaw(ctx context.Context, conn grpc.ClientConnInterface, method string, req []byte) ([]byte, error) {
+	if req == nil {
+		req = []byte{}
+	}
+
+	var res []byte
+	err := conn.Invoke(ctx, method, req, &res, grpc.ForceCodec(rawCodec{}))
+	return res, err
+}
+
+// readBytesField returns the value of the length-delimited field having the given number inside
+// the given encoded Protobuf message, or nil if the field is not set
+func readBytesField(bz []byte, field protowire.Number) ([]byte, error) {
+	var value []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		if num == field && typ == protowire.BytesType {
+			fieldValue, n := protowire.ConsumeBytes(bz)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			value = fieldValue
+			bz = bz[n:]
+			continue
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+	}
+
+	return value, nil
+}
+
+// decodeDecCoin decodes the given encoded cosmos.base.v1beta1.DecCoin message
+func decodeDecCoin(bz []byte) (sdk.DecCoin, error) {
+	denom, err := readBytesField(bz, 1)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	amountBz, err := readBytesField(bz, 2)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	amount, err := decodeDec(string(amountBz))
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(string(denom), amount), nil
+}
+
+// decodeDec decodes the given Protobuf representation of a sdk.Dec, which is the string
+// representation of its underlying integer value (e.g. "1500000000000000000" for 1.5)
+func decodeDec(value string) (sdk.Dec, error) {
+	if value == "" {
+		return sdk.ZeroDec(), nil
+	}
+
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("invalid decimal value: %s", value)
+	}
+
+	return sdk.NewDecFromBigIntWithPrec(amount, sdk.Precision), nil
+}
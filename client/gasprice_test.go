@@ -0,0 +1,320 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/desmos-labs/cosmos-go-wallet/client"
+	"github.com/desmos-labs/cosmos-go-wallet/testutils"
+)
+
+// mockGRPCConn represents a grpc.ClientConnInterface that answers with the responses registered for each method
+type mockGRPCConn struct {
+	calls     map[string]int
+	responses map[string]interface{}
+}
+
+func (m *mockGRPCConn) Invoke(_ context.Context, method string, _ interface{}, reply interface{}, _ ...grpc.CallOption) error {
+	if m.calls == nil {
+		m.calls = map[string]int{}
+	}
+	m.calls[method]++
+
+	res, ok := m.responses[method]
+	if !ok {
+		return fmt.Errorf("unknown method %s", method)
+	}
+
+	switch reply := reply.(type) {
+	case *[]byte:
+		*reply = res.([]byte)
+	case *node.ConfigResponse:
+		*reply = *res.(*node.ConfigResponse)
+	default:
+		return fmt.Errorf("invalid reply type: %T", reply)
+	}
+	return nil
+}
+
+func (m *mockGRPCConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// encodeStringField returns the Protobuf encoding of a message having the given string as its first field
+func encodeStringField(value string) []byte {
+	bz := protowire.AppendTag(nil, 1, protowire.BytesType)
+	return protowire.AppendString(bz, value)
+}
+
+// mockGasPriceProvider represents a GasPriceProvider that returns the given gas price
+type mockGasPriceProvider struct {
+	mu       sync.Mutex
+	calls    int
+	gasPrice sdk.DecCoin
+	err      error
+}
+
+func (m *mockGasPriceProvider) GasPrice(_ context.Context, _ string) (sdk.DecCoin, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls++
+	return m.gasPrice, m.err
+}
+
+// getCalls returns the number of GasPrice calls performed
+func (m *mockGasPriceProvider) getCalls() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls
+}
+
+func (suite *ClientTestSuite) TestGasPriceProviders() {
+	// DecCoin { denom = 1; amount = 2 } with amount = 0.025
+	decCoin := protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), "udaric")
+	decCoin = protowire.AppendTag(decCoin, 2, protowire.BytesType)
+	decCoin = protowire.AppendString(decCoin, "25000000000000000")
+
+	feeMarketRes := protowire.AppendTag(nil, 1, protowire.BytesType)
+	feeMarketRes = protowire.AppendBytes(feeMarketRes, decCoin)
+
+	testCases := []struct {
+		name      string
+		provider  func(conn grpc.ClientConnInterface) client.GasPriceProvider
+		responses map[string]interface{}
+		denom     string
+		shouldErr bool
+		expPrice  sdk.DecCoin
+	}{
+		{
+			name: "static provider returns the configured gas price",
+			provider: func(_ grpc.ClientConnInterface) client.GasPriceProvider {
+				return client.NewStaticGasPriceProvider(sdk.NewDecCoinFromDec("udaric", sdk.NewDecWithPrec(1, 2)))
+			},
+			denom:    "udaric",
+			expPrice: sdk.NewDecCoinFromDec("udaric", sdk.NewDecWithPrec(1, 2)),
+		},
+		{
+			name: "static provider returns error for a different denom",
+			provider: func(_ grpc.ClientConnInterface) client.GasPriceProvider {
+				return client.NewStaticGasPriceProvider(sdk.NewDecCoinFromDec("udaric", sdk.NewDecWithPrec(1, 2)))
+			},
+			denom:     "uatom",
+			shouldErr: true,
+		},
+		{
+			name: "feemarket provider decodes the gas price",
+			provider: func(conn grpc.ClientConnInterface) client.GasPriceProvider {
+				return client.NewFeeMarketGasPriceProvider(conn)
+			},
+			responses: map[string]interface{}{
+				"/feemarket.feemarket.v1.Query/GasPrice": feeMarketRes,
+			},
+			denom:    "udaric",
+			expPrice: sdk.NewDecCoinFromDec("udaric", sdk.NewDecWithPrec(25, 3)),
+		},
+		{
+			name: "feemarket provider returns query errors",
+			provider: func(conn grpc.ClientConnInterface) client.GasPriceProvider {
+				return client.NewFeeMarketGasPriceProvider(conn)
+			},
+			denom:     "udaric",
+			shouldErr: true,
+		},
+		{
+			name: "osmosis provider returns the base fee",
+			provider: func(conn grpc.ClientConnInterface) client.GasPriceProvider {
+				return client.NewOsmosisGasPriceProvider(conn)
+			},
+			responses: map[string]interface{}{
+				"/osmosis.txfees.v1beta1.Query/BaseDenom":     encodeStringField("uosmo"),
+				"/osmosis.txfees.v1beta1.Query/GetEipBaseFee": encodeStringField("2500000000000000"),
+			},
+			denom:    "uosmo",
+			expPrice: sdk.NewDecCoinFromDec("uosmo", sdk.NewDecWithPrec(25, 4)),
+		},
+		{
+			name: "osmosis provider returns error for a denom other than the base one",
+			provider: func(conn grpc.ClientConnInterface) client.GasPriceProvider {
+				return client.NewOsmosisGasPriceProvider(conn)
+			},
+			responses: map[string]interface{}{
+				"/osmosis.txfees.v1beta1.Query/BaseDenom":     encodeStringField("uosmo"),
+				"/osmosis.txfees.v1beta1.Query/GetEipBaseFee": encodeStringField("2500000000000000"),
+			},
+			denom:     "uatom",
+			shouldErr: true,
+		},
+		{
+			name: "node provider returns the minimum gas price of the denom",
+			provider: func(conn grpc.ClientConnInterface) client.GasPriceProvider {
+				return client.NewNodeGasPriceProvider(conn)
+			},
+			responses: map[string]interface{}{
+				"/cosmos.base.node.v1beta1.Service/Config": &node.ConfigResponse{MinimumGasPrice: "0.01uatom,0.02udaric"},
+			},
+			denom:    "udaric",
+			expPrice: sdk.NewDecCoinFromDec("udaric", sdk.NewDecWithPrec(2, 2)),
+		},
+		{
+			name: "node provider returns zero for a denom without minimum gas price",
+			provider: func(conn grpc.ClientConnInterface) client.GasPriceProvider {
+				return client.NewNodeGasPriceProvider(conn)
+			},
+			responses: map[string]interface{}{
+				"/cosmos.base.node.v1beta1.Service/Config": &node.ConfigResponse{MinimumGasPrice: "0.01uatom"},
+			},
+			denom:    "udaric",
+			expPrice: sdk.NewDecCoinFromDec("udaric", sdk.ZeroDec()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			conn := &mockGRPCConn{responses: tc.responses}
+			price, err := tc.provider(conn).GasPrice(context.Background(), tc.denom)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPrice.Denom, price.Denom)
+				suite.Require().True(tc.expPrice.Amount.Equal(price.Amount), price.Amount.String())
+			}
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestCachedGasPriceProvider() {
+	provider := &mockGasPriceProvider{gasPrice: sdk.NewDecCoinFromDec("udaric", sdk.NewDecWithPrec(1, 2))}
+	cached := client.NewCachedGasPriceProvider(provider, 50*time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		_, err := cached.GasPrice(context.Background(), "udaric")
+		suite.Require().NoError(err)
+	}
+	suite.Require().Equal(1, provider.calls)
+
+	time.Sleep(60 * time.Millisecond)
+	_, err := cached.GasPrice(context.Background(), "udaric")
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, provider.calls)
+}
+
+func (suite *ClientTestSuite) TestCachedGasPriceProviderStart() {
+	provider := &mockGasPriceProvider{gasPrice: sdk.NewDecCoinFromDec("udaric", sdk.NewDecWithPrec(1, 2))}
+	cached := client.NewCachedGasPriceProvider(provider, 20*time.Millisecond)
+
+	_, err := cached.GasPrice(context.Background(), "udaric")
+	suite.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cached.Start(ctx)
+
+	// Make sure the cached gas price is refreshed in the background
+	suite.Require().Eventually(func() bool {
+		return provider.getCalls() >= 3
+	}, time.Second, 5*time.Millisecond)
+
+	// Make sure the refresh stops once the context is done
+	cancel()
+	time.Sleep(30 * time.Millisecond)
+	calls := provider.getCalls()
+	time.Sleep(50 * time.Millisecond)
+	suite.Require().Equal(calls, provider.getCalls())
+}
+
+func (suite *ClientTestSuite) TestGetFeesCtx() {
+	testCases := []struct {
+		name      string
+		provider  client.GasPriceProvider
+		shouldErr bool
+		expFees   sdk.Coins
+	}{
+		{
+			name:     "fees are computed using the provider gas price",
+			provider: &mockGasPriceProvider{gasPrice: sdk.NewDecCoinFromDec("udaric", sdk.NewDecWithPrec(25, 3))},
+			expFees:  sdk.NewCoins(sdk.NewInt64Coin("udaric", 5_000)),
+		},
+		{
+			name:      "provider errors are returned",
+			provider:  &mockGasPriceProvider{err: fmt.Errorf("error")},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.client.GasPriceProvider = tc.provider
+
+			fees, err := suite.client.GetFeesCtx(context.Background(), 200_000)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+
+				// GetFees always uses the gas price set inside the config
+				suite.Require().Equal(
+					sdk.NewCoins(sdk.NewCoin(suite.client.GasPrice.Denom, suite.client.GasPrice.Amount.MulInt64(200_000).Ceil().RoundInt())),
+					suite.client.GetFees(200_000),
+				)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFees, fees)
+			}
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestGetAffordableFees() {
+	gasPrices := []sdk.DecCoin{
+		sdk.NewDecCoinFromDec("udaric", sdk.NewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.NewDecWithPrec(2, 2)),
+	}
+
+	testCases := []struct {
+		name      string
+		balances  sdk.Coins
+		shouldErr bool
+		expFees   sdk.Coins
+	}{
+		{
+			name:     "first denom is used when the balance is enough",
+			balances: sdk.NewCoins(sdk.NewInt64Coin(gasPrices[0].Denom, 2_000), sdk.NewInt64Coin(gasPrices[1].Denom, 4_000)),
+			expFees:  sdk.NewCoins(sdk.NewInt64Coin(gasPrices[0].Denom, 2_000)),
+		},
+		{
+			name:     "second denom is used when the first balance is not enough",
+			balances: sdk.NewCoins(sdk.NewInt64Coin(gasPrices[0].Denom, 1_999), sdk.NewInt64Coin(gasPrices[1].Denom, 4_000)),
+			expFees:  sdk.NewCoins(sdk.NewInt64Coin(gasPrices[1].Denom, 4_000)),
+		},
+		{
+			name:      "insufficient funds error is returned when no balance is enough",
+			balances:  sdk.NewCoins(sdk.NewInt64Coin(gasPrices[0].Denom, 1_999)),
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.client.GasPrices = gasPrices
+			suite.client.GasPriceProvider = client.NewStaticGasPriceProvider(gasPrices...)
+			suite.client.BankClient = &testutils.MockBankClient{Balances: tc.balances}
+
+			fees, err := suite.client.GetAffordableFeesCtx(context.Background(), "desmos1q62k9kvjy7v2wh0yt9jqaepnzezz3s49j9gnpk", 200_000)
+			if tc.shouldErr {
+				suite.Require().ErrorIs(err, client.ErrInsufficientFunds)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFees, fees)
+			}
+		})
+	}
+}
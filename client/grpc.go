@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc.ClientConnInterface = &failoverGRPCConn{}
+
+// failoverGRPCConn is a grpc.ClientConnInterface that sends each request to the preferred available node,
+// falling back to the other ones when a node cannot be reached
+type failoverGRPCConn struct {
+	pool *endpointPool[grpc.ClientConnInterface]
+}
+
+// newFailoverGRPCConn returns a new failoverGRPCConn using the given endpoints
+func newFailoverGRPCConn(pool *endpointPool[grpc.ClientConnInterface]) *failoverGRPCConn {
+	return &failoverGRPCConn{
+		pool: pool,
+	}
+}
+
+// isGRPCConnectionError tells whether the given error has been returned because the node could not be reached
+func isGRPCConnectionError(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
+
+// checkGRPCHealth returns whether the node is catching up and its latest block height
+func checkGRPCHealth(ctx context.Context, conn grpc.ClientConnInterface) (bool, int64, error) {
+	serviceClient := tmservice.NewServiceClient(conn)
+
+	syncingRes, err := serviceClient.GetSyncing(ctx, &tmservice.GetSyncingRequest{})
+	if err != nil {
+		return false, 0, err
+	}
+
+	blockRes, err := serviceClient.GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	if err != nil {
+		return false, 0, err
+	}
+
+	// Nodes running Cosmos SDK versions older than v0.47 only return the Tendermint block
+	if blockRes.SdkBlock != nil {
+		return syncingRes.Syncing, blockRes.SdkBlock.Header.Height, nil
+	}
+	return syncingRes.Syncing, blockRes.Block.Header.Height, nil
+}
+
+// Invoke implements grpc.ClientConnInterface
+func (c *failoverGRPCConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	_, err := execute(ctx, c.pool, isGRPCConnectionError, func(conn grpc.ClientConnInterface) (struct{}, error) {
+		return struct{}{}, conn.Invoke(ctx, method, args, reply, opts...)
+	})
+	return err
+}
+
+// NewStream implements grpc.ClientConnInterface.
+// Only the stream creation falls back to other nodes, errors happening after that are returned as they are.
+func (c *failoverGRPCConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return execute(ctx, c.pool, isGRPCConnectionError, func(conn grpc.ClientConnInterface) (grpc.ClientStream, error) {
+		return conn.NewStream(ctx, desc, method, opts...)
+	})
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+)
+
+// FeeLimits contains the maximum gas and fees that a transaction can use before being signed.
+// Zero or empty values mean that no limit is applied.
+type FeeLimits struct {
+	// MaxGas is the maximum gas limit of a transaction
+	MaxGas uint64
+
+	// MaxFee contains the maximum fee amount that a transaction can pay for each denom.
+	// If set, the fees can only be paid using the denoms it contains.
+	MaxFee sdk.Coins
+
+	// MaxGasPrice contains the maximum gas price that a transaction can pay for each denom.
+	// If set, the fees can only be paid using the denoms it contains.
+	MaxGasPrice sdk.DecCoins
+}
+
+// NewFeeLimitsFromConfig returns the FeeLimits set inside the given config
+func NewFeeLimitsFromConfig(config *types.ChainConfig) (FeeLimits, error) {
+	maxFee, err := sdk.ParseCoinsNormalized(config.MaxFee)
+	if err != nil {
+		return FeeLimits{}, fmt.Errorf("error while parsing max fee: %s", err)
+	}
+
+	maxGasPrice, err := sdk.ParseDecCoins(config.MaxGasPrice)
+	if err != nil {
+		return FeeLimits{}, fmt.Errorf("error while parsing max gas price: %s", err)
+	}
+
+	return FeeLimits{
+		MaxGas:      config.MaxGas,
+		MaxFee:      maxFee,
+		MaxGasPrice: maxGasPrice,
+	}, nil
+}
+
+// Check returns an ErrFeeLimitExceeded error if the given gas limit and fees do not respect the limits
+func (l FeeLimits) Check(gasLimit uint64, fees sdk.Coins) error {
+	if l.MaxGas > 0 && gasLimit > l.MaxGas {
+		return fmt.Errorf("%w: gas limit %d is greater than the maximum %d", ErrFeeLimitExceeded, gasLimit, l.MaxGas)
+	}
+
+	for _, fee := range fees {
+		if !l.MaxFee.Empty() {
+			maxFee := l.MaxFee.AmountOf(fee.Denom)
+			if fee.Amount.GT(maxFee) {
+				return fmt.Errorf("%w: fee %s is greater than the maximum %s%s", ErrFeeLimitExceeded, fee, maxFee, fee.Denom)
+			}
+		}
+
+		if !l.MaxGasPrice.Empty() && gasLimit > 0 {
+			gasPrice := sdk.NewDecFromInt(fee.Amount).QuoInt64(int64(gasLimit))
+			maxGasPrice := l.MaxGasPrice.AmountOf(fee.Denom)
+			if gasPrice.GT(maxGasPrice) {
+				return fmt.Errorf("%w: gas price %s%s is greater than the maximum %s%s",
+					ErrFeeLimitExceeded, gasPrice, fee.Denom, maxGasPrice, fee.Denom)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package client_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/cosmos-go-wallet/client"
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+)
+
+func (suite *ClientTestSuite) TestFeeLimits() {
+	limits, err := client.NewFeeLimitsFromConfig(&types.ChainConfig{
+		MaxGas:      500_000,
+		MaxFee:      "5000udaric,10000uatom",
+		MaxGasPrice: "0.02udaric,0.1uatom",
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		limits    client.FeeLimits
+		gasLimit  uint64
+		fees      sdk.Coins
+		shouldErr bool
+	}{
+		{
+			name:     "empty limits allow everything",
+			limits:   client.FeeLimits{},
+			gasLimit: 100_000_000,
+			fees:     sdk.NewCoins(sdk.NewInt64Coin("udaric", 100_000_000)),
+		},
+		{
+			name:     "gas and fees within the limits return no error",
+			limits:   limits,
+			gasLimit: 200_000,
+			fees:     sdk.NewCoins(sdk.NewInt64Coin("udaric", 2_000)),
+		},
+		{
+			name:      "gas limit greater than the maximum returns error",
+			limits:    limits,
+			gasLimit:  500_001,
+			fees:      sdk.NewCoins(sdk.NewInt64Coin("udaric", 2_000)),
+			shouldErr: true,
+		},
+		{
+			name:      "fee greater than the maximum returns error",
+			limits:    limits,
+			gasLimit:  500_000,
+			fees:      sdk.NewCoins(sdk.NewInt64Coin("udaric", 5_001)),
+			shouldErr: true,
+		},
+		{
+			name:      "fee in a denom without maximum returns error",
+			limits:    limits,
+			gasLimit:  200_000,
+			fees:      sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1)),
+			shouldErr: true,
+		},
+		{
+			name:      "gas price greater than the maximum returns error",
+			limits:    limits,
+			gasLimit:  50_000,
+			fees:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 5_001)),
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.limits.Check(tc.gasLimit, tc.fees)
+			if tc.shouldErr {
+				suite.Require().ErrorIs(err, client.ErrFeeLimitExceeded)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
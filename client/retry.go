@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/url"
+	"regexp"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// gatewayErrorRegex matches the errors returned by the RPC client when a load balancer in front of
+	// the node answers with a 502, 503 or 504 status code
+	gatewayErrorRegex = regexp.MustCompile(`Status: 50[234]`)
+)
+
+// RetryPolicy represents the policy used to retry the requests that fail due to transient node errors
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is performed, including the first one.
+	// A value lower than 2 disables the retries.
+	MaxAttempts uint
+
+	// InitialBackoff is the time waited before the first retry
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum time waited between two attempts
+	MaxBackoff time.Duration
+
+	// BackoffMultiplier is the factor by which the backoff is multiplied after each retry
+	BackoffMultiplier float64
+
+	// Jitter is the fraction (between 0 and 1) by which each backoff is randomly increased or decreased
+	Jitter float64
+
+	// RetryableCodes contains the gRPC codes of the errors that should be retried.
+	// RPC connection errors, as well as 502, 503 and 504 HTTP errors, are always retried.
+	RetryableCodes []codes.Code
+
+	// RetryableABCIErrors contains the errors that, when returned by CheckTx, cause a transaction to be broadcast again
+	RetryableABCIErrors []*errorsmod.Error
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used by default
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:         3,
+		InitialBackoff:      500 * time.Millisecond,
+		MaxBackoff:          5 * time.Second,
+		BackoffMultiplier:   2,
+		Jitter:              0.2,
+		RetryableCodes:      []codes.Code{codes.Unavailable, codes.DeadlineExceeded},
+		RetryableABCIErrors: []*errorsmod.Error{sdkerrors.ErrMempoolIsFull},
+	}
+}
+
+// NoRetryPolicy returns a RetryPolicy that never retries any request
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+// IsRetryableError tells whether the given error returned by a node request should be retried
+func (p RetryPolicy) IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if s, ok := status.FromError(err); ok {
+		for _, code := range p.RetryableCodes {
+			if s.Code() == code {
+				return true
+			}
+		}
+		return false
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	return gatewayErrorRegex.MatchString(err.Error())
+}
+
+// IsRetryableResponse tells whether the given broadcast response should cause the transaction to be broadcast again.
+// Only the transactions that have been rejected by CheckTx can be broadcast again, since they did not enter the mempool.
+func (p RetryPolicy) IsRetryableResponse(res *sdk.TxResponse) bool {
+	if res == nil || res.Code == 0 || res.Height != 0 {
+		return false
+	}
+
+	for _, abciErr := range p.RetryableABCIErrors {
+		if res.Codespace == abciErr.Codespace() && res.Code == abciErr.ABCICode() {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the time that should be waited before performing the given attempt, starting from 2
+func (p RetryPolicy) Backoff(attempt uint) time.Duration {
+	multiplier := math.Max(p.BackoffMultiplier, 1)
+	backoff := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt)-2)
+	if p.MaxBackoff > 0 {
+		backoff = math.Min(backoff, float64(p.MaxBackoff))
+	}
+
+	if p.Jitter > 0 {
+		backoff += backoff * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(backoff)
+}
+
+// canRetry tells whether a new attempt can be performed after the given one
+func (p RetryPolicy) canRetry(attempt uint) bool {
+	return attempt < p.MaxAttempts
+}
+
+// wait waits before performing the given attempt, returning an error if the context is done before that
+func (p RetryPolicy) wait(ctx context.Context, attempt uint) error {
+	timer := time.NewTimer(p.Backoff(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// withRetry calls the given function until it succeeds, it returns an error that is not retryable
+// or the maximum number of attempts is reached
+func withRetry[R any](ctx context.Context, policy RetryPolicy, fn func() (R, error)) (R, error) {
+	for attempt := uint(1); ; attempt++ {
+		res, err := fn()
+		if err == nil || ctx.Err() != nil || !policy.IsRetryableError(err) || !policy.canRetry(attempt) {
+			return res, err
+		}
+
+		if waitErr := policy.wait(ctx, attempt+1); waitErr != nil {
+			return res, err
+		}
+	}
+}
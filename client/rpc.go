@@ -0,0 +1,234 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+)
+
+var _ rpcclient.Client = &failoverRPCClient{}
+
+// failoverRPCClient is a rpcclient.Client that sends each request to the preferred available node,
+// falling back to the other ones when a node cannot be reached.
+// Transactions are broadcast to the preferred node only, since a node that cannot be reached might have received
+// them anyway: broadcasting them again is left to the Client retry logic, which first makes sure they are not
+// included inside a block.
+// The service and events methods are handled by the first configured node, since subscriptions
+// are bound to the websocket connection of a single node.
+type failoverRPCClient struct {
+	rpcclient.Client
+	pool *endpointPool[rpcclient.Client]
+}
+
+// newFailoverRPCClient returns a new failoverRPCClient using the given endpoints
+func newFailoverRPCClient(pool *endpointPool[rpcclient.Client]) *failoverRPCClient {
+	return &failoverRPCClient{
+		Client: pool.primary(),
+		pool:   pool,
+	}
+}
+
+// isRPCConnectionError tells whether the given error has been returned because the node could not be reached
+// or did not answer properly, instead of being an error returned by the node itself
+func isRPCConnectionError(err error) bool {
+	var rpcErr *rpctypes.RPCError
+	return !errors.As(err, &rpcErr)
+}
+
+// rpcExecute calls the given function using the preferred available node
+func rpcExecute[R any](ctx context.Context, c *failoverRPCClient, fn func(client rpcclient.Client) (R, error)) (R, error) {
+	return execute(ctx, c.pool, isRPCConnectionError, fn)
+}
+
+// rpcExecuteOnce calls the given function using the preferred available node, without falling back to the other ones
+func rpcExecuteOnce[R any](ctx context.Context, c *failoverRPCClient, fn func(client rpcclient.Client) (R, error)) (R, error) {
+	return executeOnce(ctx, c.pool, isRPCConnectionError, fn)
+}
+
+// checkRPCHealth returns whether the node is catching up and its latest block height
+func checkRPCHealth(ctx context.Context, client rpcclient.Client) (bool, int64, error) {
+	status, err := client.Status(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	return status.SyncInfo.CatchingUp, status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (c *failoverRPCClient) ABCIInfo(ctx context.Context) (*coretypes.ResultABCIInfo, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultABCIInfo, error) {
+		return client.ABCIInfo(ctx)
+	})
+}
+
+func (c *failoverRPCClient) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultABCIQuery, error) {
+		return client.ABCIQuery(ctx, path, data)
+	})
+}
+
+func (c *failoverRPCClient) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultABCIQuery, error) {
+		return client.ABCIQueryWithOptions(ctx, path, data, opts)
+	})
+}
+
+func (c *failoverRPCClient) BroadcastTxCommit(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	return rpcExecuteOnce(ctx, c, func(client rpcclient.Client) (*coretypes.ResultBroadcastTxCommit, error) {
+		return client.BroadcastTxCommit(ctx, tx)
+	})
+}
+
+func (c *failoverRPCClient) BroadcastTxAsync(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return rpcExecuteOnce(ctx, c, func(client rpcclient.Client) (*coretypes.ResultBroadcastTx, error) {
+		return client.BroadcastTxAsync(ctx, tx)
+	})
+}
+
+func (c *failoverRPCClient) BroadcastTxSync(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return rpcExecuteOnce(ctx, c, func(client rpcclient.Client) (*coretypes.ResultBroadcastTx, error) {
+		return client.BroadcastTxSync(ctx, tx)
+	})
+}
+
+func (c *failoverRPCClient) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultBlock, error) {
+		return client.Block(ctx, height)
+	})
+}
+
+func (c *failoverRPCClient) BlockByHash(ctx context.Context, hash []byte) (*coretypes.ResultBlock, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultBlock, error) {
+		return client.BlockByHash(ctx, hash)
+	})
+}
+
+func (c *failoverRPCClient) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultBlockResults, error) {
+		return client.BlockResults(ctx, height)
+	})
+}
+
+func (c *failoverRPCClient) Header(ctx context.Context, height *int64) (*coretypes.ResultHeader, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultHeader, error) {
+		return client.Header(ctx, height)
+	})
+}
+
+func (c *failoverRPCClient) HeaderByHash(ctx context.Context, hash bytes.HexBytes) (*coretypes.ResultHeader, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultHeader, error) {
+		return client.HeaderByHash(ctx, hash)
+	})
+}
+
+func (c *failoverRPCClient) Commit(ctx context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultCommit, error) {
+		return client.Commit(ctx, height)
+	})
+}
+
+func (c *failoverRPCClient) Validators(ctx context.Context, height *int64, page, perPage *int) (*coretypes.ResultValidators, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultValidators, error) {
+		return client.Validators(ctx, height, page, perPage)
+	})
+}
+
+func (c *failoverRPCClient) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.ResultTx, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultTx, error) {
+		return client.Tx(ctx, hash, prove)
+	})
+}
+
+func (c *failoverRPCClient) TxSearch(ctx context.Context, query string, prove bool, page, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultTxSearch, error) {
+		return client.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	})
+}
+
+func (c *failoverRPCClient) BlockSearch(ctx context.Context, query string, page, perPage *int, orderBy string) (*coretypes.ResultBlockSearch, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultBlockSearch, error) {
+		return client.BlockSearch(ctx, query, page, perPage, orderBy)
+	})
+}
+
+func (c *failoverRPCClient) Genesis(ctx context.Context) (*coretypes.ResultGenesis, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultGenesis, error) {
+		return client.Genesis(ctx)
+	})
+}
+
+func (c *failoverRPCClient) GenesisChunked(ctx context.Context, id uint) (*coretypes.ResultGenesisChunk, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultGenesisChunk, error) {
+		return client.GenesisChunked(ctx, id)
+	})
+}
+
+func (c *failoverRPCClient) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultBlockchainInfo, error) {
+		return client.BlockchainInfo(ctx, minHeight, maxHeight)
+	})
+}
+
+func (c *failoverRPCClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultStatus, error) {
+		return client.Status(ctx)
+	})
+}
+
+func (c *failoverRPCClient) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultNetInfo, error) {
+		return client.NetInfo(ctx)
+	})
+}
+
+func (c *failoverRPCClient) DumpConsensusState(ctx context.Context) (*coretypes.ResultDumpConsensusState, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultDumpConsensusState, error) {
+		return client.DumpConsensusState(ctx)
+	})
+}
+
+func (c *failoverRPCClient) ConsensusState(ctx context.Context) (*coretypes.ResultConsensusState, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultConsensusState, error) {
+		return client.ConsensusState(ctx)
+	})
+}
+
+func (c *failoverRPCClient) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultConsensusParams, error) {
+		return client.ConsensusParams(ctx, height)
+	})
+}
+
+func (c *failoverRPCClient) Health(ctx context.Context) (*coretypes.ResultHealth, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultHealth, error) {
+		return client.Health(ctx)
+	})
+}
+
+func (c *failoverRPCClient) BroadcastEvidence(ctx context.Context, evidence tmtypes.Evidence) (*coretypes.ResultBroadcastEvidence, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultBroadcastEvidence, error) {
+		return client.BroadcastEvidence(ctx, evidence)
+	})
+}
+
+func (c *failoverRPCClient) UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultUnconfirmedTxs, error) {
+		return client.UnconfirmedTxs(ctx, limit)
+	})
+}
+
+func (c *failoverRPCClient) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconfirmedTxs, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultUnconfirmedTxs, error) {
+		return client.NumUnconfirmedTxs(ctx)
+	})
+}
+
+func (c *failoverRPCClient) CheckTx(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultCheckTx, error) {
+	return rpcExecute(ctx, c, func(client rpcclient.Client) (*coretypes.ResultCheckTx, error) {
+		return client.CheckTx(ctx, tx)
+	})
+}
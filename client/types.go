@@ -4,10 +4,66 @@ import (
 	"encoding/hex"
 	"strings"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
+// BroadcastMode represents the method used to broadcast a transaction to the chain
+type BroadcastMode string
+
+const (
+	// BroadcastAsync returns right after the transaction has been sent to the node
+	BroadcastAsync BroadcastMode = "async"
+
+	// BroadcastSync returns after the transaction has been checked by the node
+	BroadcastSync BroadcastMode = "sync"
+
+	// BroadcastCommit returns after the transaction has been included inside a block
+	BroadcastCommit BroadcastMode = "commit"
+)
+
+// SimulationResult contains the result of the simulation of a transaction
+type SimulationResult struct {
+	// GasWanted and GasUsed are the amounts of gas wanted and used during the simulation
+	GasWanted uint64
+	GasUsed   uint64
+
+	// AdjustedGas is the amount of gas used multiplied by the gas adjustment, which should be used
+	// as the gas limit of the transaction
+	AdjustedGas uint64
+
+	// Events contains the events emitted during the simulation
+	Events []abci.Event
+
+	// MsgResponses contains the responses of the messages, in the same order as the messages.
+	// Responses that cannot be decoded using the client codec are set to nil, and can be read from Result.
+	MsgResponses []sdktx.MsgResponse
+
+	// Log and Logs contain the raw and the parsed logs of the simulation
+	Log  string
+	Logs sdk.ABCIMessageLogs
+
+	// Result is the raw result returned by the node
+	Result *sdk.Result
+}
+
+// NodeInfo contains the information of a chain and of the node a Client is connected to
+type NodeInfo struct {
+	ChainID string
+
+	// Moniker and NodeVersion are the name and the CometBFT version of the node
+	Moniker     string
+	NodeVersion string
+
+	// AppName, AppVersion and AppProtocolVersion are the name, the version and the protocol version
+	// of the application run by the chain
+	AppName            string
+	AppVersion         string
+	AppProtocolVersion uint64
+}
+
 // NewResponseFormatBroadcastTxCommit returns a TxResponse given a
 // ResultBroadcastTxCommit from tendermint.
 // Note: This is a backport from Cosmos SDK v0.45.x since it was removed inside Cosmos SDK v0.47.x
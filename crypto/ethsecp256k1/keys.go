@@ -0,0 +1,207 @@
+// Package ethsecp256k1 implements the eth_secp256k1 keys used by Ethermint-based chains (e.g. Evmos, Injective, Cronos).
+// The keys are generated from the same Protobuf messages as Ethermint (see proto/ethermint/crypto/v1/ethsecp256k1),
+// so that transactions signed with them are accepted by such chains.
+//
+// Since the keys use the same Protobuf and Amino names as the Ethermint ones, this package should not be used
+// together with the Ethermint ethsecp256k1 package: applications depending on Ethermint should use its keys instead.
+// When both packages are linked, the names are registered by the first package initialized and skipped by the other
+// one, and RegisterInterfaces must not be called on a registry where the Ethermint keys are registered too.
+package ethsecp256k1
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// PrivKeySize defines the size of the PrivKey bytes
+	PrivKeySize = 32
+
+	// PubKeySize defines the size of the compressed PubKey bytes
+	PubKeySize = 33
+
+	// KeyType is the string constant for the eth_secp256k1 algorithm
+	KeyType = "eth_secp256k1"
+
+	// PubKeyName and PrivKeyName are the Protobuf message names of the keys
+	PubKeyName  = "ethermint.crypto.v1.ethsecp256k1.PubKey"
+	PrivKeyName = "ethermint.crypto.v1.ethsecp256k1.PrivKey"
+
+	// PubKeyAminoName and PrivKeyAminoName are the Amino names of the keys
+	PubKeyAminoName  = "ethermint/PubKeyEthSecp256k1"
+	PrivKeyAminoName = "ethermint/PrivKeyEthSecp256k1"
+)
+
+var (
+	_ cryptotypes.PrivKey = &PrivKey{}
+	_ cryptotypes.PubKey  = &PubKey{}
+)
+
+func init() {
+	// Register the keys inside the global Amino codecs as it is done for the secp256k1 keys, so that they can be
+	// used when signing with the amino JSON sign mode and inside legacy Amino multisig keys
+	for _, cdc := range []*codec.LegacyAmino{legacy.Cdc, kmultisig.AminoCdc} {
+		if !isAminoRegistered(cdc) {
+			RegisterLegacyAminoCodec(cdc)
+		}
+	}
+}
+
+// RegisterLegacyAminoCodec registers the eth_secp256k1 keys inside the given Amino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&PubKey{}, PubKeyAminoName, nil)
+	cdc.RegisterConcrete(&PrivKey{}, PrivKeyAminoName, nil)
+}
+
+// isAminoRegistered tells whether a public key has already been registered using PubKeyAminoName inside the
+// given codec, either by this package or by the Ethermint one
+func isAminoRegistered(cdc *codec.LegacyAmino) bool {
+	var pubKey cryptotypes.PubKey
+	return cdc.UnmarshalJSON([]byte(`{"type":"`+PubKeyAminoName+`","value":{}}`), &pubKey) == nil
+}
+
+// RegisterInterfaces registers the eth_secp256k1 keys implementations inside the given registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &PrivKey{})
+}
+
+// Keccak256 returns the Keccak256 hash of the given data
+func Keccak256(data []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(data)
+	return hasher.Sum(nil)
+}
+
+// GenPrivKey generates a new random eth_secp256k1 private key
+func GenPrivKey() (*PrivKey, error) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return &PrivKey{Key: key.Serialize()}, nil
+}
+
+// Bytes implements cryptotypes.PrivKey
+func (privKey *PrivKey) Bytes() []byte {
+	if privKey == nil {
+		return nil
+	}
+	return privKey.Key
+}
+
+// PubKey implements cryptotypes.PrivKey
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	key := secp256k1.PrivKeyFromBytes(privKey.Key)
+	return &PubKey{Key: key.PubKey().SerializeCompressed()}
+}
+
+// Equals implements cryptotypes.PrivKey
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+// Type implements cryptotypes.PrivKey
+func (privKey *PrivKey) Type() string {
+	return KeyType
+}
+
+// XXX_MessageName returns the Protobuf message name of the key, so that it is packed properly even when the name
+// has been registered by the Ethermint package first
+func (*PrivKey) XXX_MessageName() string {
+	return PrivKeyName
+}
+
+// Sign implements cryptotypes.PrivKey.
+// The Keccak256 hash of the given message is signed, and the signature is returned in the [R || S || V] format
+// where V is 0 or 1.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	if len(privKey.Key) != PrivKeySize {
+		return nil, fmt.Errorf("invalid private key length: expected %d, got %d", PrivKeySize, len(privKey.Key))
+	}
+
+	key := secp256k1.PrivKeyFromBytes(privKey.Key)
+
+	// The compact signature is in the [V || R || S] format, where V is 27 + recovery id
+	compact := ecdsa.SignCompact(key, Keccak256(msg), false)
+	return append(compact[1:], compact[0]-27), nil
+}
+
+// Address implements cryptotypes.PubKey.
+// The address is computed as the last 20 bytes of the Keccak256 hash of the uncompressed public key.
+// An empty address is returned if the key is not valid.
+func (pubKey *PubKey) Address() cryptotypes.Address {
+	key, err := secp256k1.ParsePubKey(pubKey.Key)
+	if err != nil {
+		return nil
+	}
+
+	return Keccak256(key.SerializeUncompressed()[1:])[12:]
+}
+
+// Bytes implements cryptotypes.PubKey
+func (pubKey *PubKey) Bytes() []byte {
+	if pubKey == nil {
+		return nil
+	}
+	return pubKey.Key
+}
+
+// VerifySignature implements cryptotypes.PubKey.
+// The signature must be in the [R || S || V] format, or [R || S] without the recovery id.
+func (pubKey *PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	if len(sig) == 65 {
+		sig = sig[:64]
+	}
+	if len(sig) != 64 {
+		return false
+	}
+
+	key, err := secp256k1.ParsePubKey(pubKey.Key)
+	if err != nil {
+		return false
+	}
+
+	var r, s secp256k1.ModNScalar
+	if r.SetByteSlice(sig[:32]) || s.SetByteSlice(sig[32:]) {
+		return false
+	}
+
+	// Reject malleable signatures
+	if s.IsOverHalfOrder() {
+		return false
+	}
+
+	return ecdsa.NewSignature(&r, &s).Verify(Keccak256(msg), key)
+}
+
+// Equals implements cryptotypes.PubKey
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// Type implements cryptotypes.PubKey
+func (pubKey *PubKey) Type() string {
+	return KeyType
+}
+
+// XXX_MessageName returns the Protobuf message name of the key, so that it is packed properly even when the name
+// has been registered by the Ethermint package first
+func (*PubKey) XXX_MessageName() string {
+	return PubKeyName
+}
+
+// String implements fmt.Stringer
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("EthPubKeySecp256k1{%X}", pubKey.Key)
+}
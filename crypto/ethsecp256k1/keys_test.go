@@ -0,0 +1,183 @@
+package ethsecp256k1_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/desmos-labs/cosmos-go-wallet/crypto/ethsecp256k1"
+	"github.com/desmos-labs/cosmos-go-wallet/testutils"
+)
+
+func TestKeysTestSuite(t *testing.T) {
+	suite.Run(t, new(KeysTestSuite))
+}
+
+type KeysTestSuite struct {
+	suite.Suite
+
+	privKey *ethsecp256k1.PrivKey
+}
+
+func (suite *KeysTestSuite) SetupTest() {
+	// Well-known development private key, whose address is 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266
+	bz, err := hex.DecodeString("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	suite.Require().NoError(err)
+	suite.privKey = &ethsecp256k1.PrivKey{Key: bz}
+}
+
+func (suite *KeysTestSuite) TestAddress() {
+	address := suite.privKey.PubKey().Address()
+	suite.Require().Equal("f39fd6e51aad88f6f4ce6ab8827279cfffb92266", hex.EncodeToString(address))
+
+	invalidPubKey := &ethsecp256k1.PubKey{Key: []byte{0x01, 0x02}}
+	suite.Require().Empty(invalidPubKey.Address())
+}
+
+func (suite *KeysTestSuite) TestSignAndVerify() {
+	msg := []byte("message to be signed")
+
+	sig, err := suite.privKey.Sign(msg)
+	suite.Require().NoError(err)
+	suite.Require().Len(sig, 65)
+	suite.Require().True(sig[64] == 0 || sig[64] == 1)
+
+	pubKey := suite.privKey.PubKey()
+	suite.Require().True(pubKey.VerifySignature(msg, sig))
+	suite.Require().True(pubKey.VerifySignature(msg, sig[:64]))
+	suite.Require().False(pubKey.VerifySignature([]byte("different message"), sig))
+	suite.Require().False(pubKey.VerifySignature(msg, sig[:63]))
+}
+
+func (suite *KeysTestSuite) TestAnyPacking() {
+	registry := codectypes.NewInterfaceRegistry()
+	ethsecp256k1.RegisterInterfaces(registry)
+
+	pubKey := suite.privKey.PubKey()
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	suite.Require().NoError(err)
+	suite.Require().Equal("/ethermint.crypto.v1.ethsecp256k1.PubKey", pubKeyAny.TypeUrl)
+
+	var unpackedPubKey cryptotypes.PubKey
+	err = registry.UnpackAny(&codectypes.Any{TypeUrl: pubKeyAny.TypeUrl, Value: pubKeyAny.Value}, &unpackedPubKey)
+	suite.Require().NoError(err)
+	suite.Require().True(pubKey.Equals(unpackedPubKey))
+
+	privKeyAny, err := codectypes.NewAnyWithValue(suite.privKey)
+	suite.Require().NoError(err)
+
+	var unpackedPrivKey cryptotypes.PrivKey
+	err = registry.UnpackAny(&codectypes.Any{TypeUrl: privKeyAny.TypeUrl, Value: privKeyAny.Value}, &unpackedPrivKey)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.privKey.Equals(unpackedPrivKey))
+}
+
+func (suite *KeysTestSuite) TestLegacyAmino() {
+	pubKey := suite.privKey.PubKey()
+
+	bz, err := legacy.Cdc.MarshalJSON(pubKey)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(bz), ethsecp256k1.PubKeyAminoName)
+
+	var unmarshalledPubKey cryptotypes.PubKey
+	err = legacy.Cdc.UnmarshalJSON(bz, &unmarshalledPubKey)
+	suite.Require().NoError(err)
+	suite.Require().True(pubKey.Equals(unmarshalledPubKey))
+
+	// Make sure the keys can be used inside legacy Amino multisig keys
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{pubKey})
+	bz, err = kmultisig.AminoCdc.MarshalJSON(multisigPubKey)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(bz), ethsecp256k1.PubKeyAminoName)
+	suite.Require().NotEmpty(multisigPubKey.Address())
+}
+
+func (suite *KeysTestSuite) TestTxEncoding() {
+	testCases := []struct {
+		name     string
+		signMode signing.SignMode
+	}{
+		{
+			name:     "direct sign mode",
+			signMode: signing.SignMode_SIGN_MODE_DIRECT,
+		},
+		{
+			name:     "amino JSON sign mode",
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.testTxEncoding(tc.signMode)
+		})
+	}
+}
+
+// testTxEncoding signs a transaction using the given sign mode, and verifies the signature after encoding
+// and decoding the transaction
+func (suite *KeysTestSuite) testTxEncoding(signMode signing.SignMode) {
+	txConfig := testutils.MakeTestEncodingConfig().TxConfig
+	pubKey := suite.privKey.PubKey()
+
+	// Build the bech32 address directly to avoid relying on the global address prefix
+	address, err := sdk.Bech32ifyAddressBytes("evmos", pubKey.Address())
+	suite.Require().NoError(err)
+
+	txBuilder := txConfig.NewTxBuilder()
+	err = txBuilder.SetMsgs(&banktypes.MsgSend{
+		FromAddress: address,
+		ToAddress:   address,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("aevmos", 1)),
+	})
+	suite.Require().NoError(err)
+	txBuilder.SetGasLimit(200_000)
+
+	signerData := authsigning.SignerData{
+		Address:       address,
+		ChainID:       "evmos_9001-2",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubKey,
+	}
+
+	// Set an empty signature first so that the signer info is included inside the signed bytes
+	err = txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: signerData.Sequence,
+	})
+	suite.Require().NoError(err)
+
+	sig, err := clienttx.SignWithPrivKey(signMode, signerData, txBuilder, suite.privKey, txConfig, signerData.Sequence)
+	suite.Require().NoError(err)
+	suite.Require().NoError(txBuilder.SetSignatures(sig))
+
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	suite.Require().NoError(err)
+
+	decodedTx, err := txConfig.TxDecoder()(txBytes)
+	suite.Require().NoError(err)
+
+	sigTx, ok := decodedTx.(authsigning.SigVerifiableTx)
+	suite.Require().True(ok)
+
+	sigs, err := sigTx.GetSignaturesV2()
+	suite.Require().NoError(err)
+	suite.Require().Len(sigs, 1)
+	suite.Require().True(pubKey.Equals(sigs[0].PubKey))
+
+	err = authsigning.VerifySignature(sigs[0].PubKey, signerData, sigs[0].Data, txConfig.SignModeHandler(), decodedTx)
+	suite.Require().NoError(err)
+}
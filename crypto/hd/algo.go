@@ -0,0 +1,134 @@
+package hd
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdked25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/go-bip39"
+
+	"github.com/desmos-labs/cosmos-go-wallet/crypto/ethsecp256k1"
+)
+
+const (
+	// EthSecp256k1Type represents the eth_secp256k1 signature system used by Ethermint-based chains
+	EthSecp256k1Type = hd.PubKeyType(ethsecp256k1.KeyType)
+)
+
+var (
+	// EthSecp256k1 uses the secp256k1 parameters along with Keccak256 hashing, as Ethereum does
+	EthSecp256k1 = ethSecp256k1Algo{}
+
+	// Ed25519 uses the ed25519 parameters along with SLIP-10 derivation
+	Ed25519 = ed25519Algo{}
+
+	// SupportedAlgorithms contains all the supported signing algorithms
+	SupportedAlgorithms = keyring.SigningAlgoList{hd.Secp256k1, EthSecp256k1, Ed25519}
+)
+
+// GetAlgorithm returns the signing algorithm having the given name.
+// If the name is empty, the secp256k1 algorithm is returned.
+func GetAlgorithm(name string) (keyring.SignatureAlgo, error) {
+	if name == "" {
+		return hd.Secp256k1, nil
+	}
+
+	algo, err := keyring.NewSigningAlgoFromString(name, SupportedAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported key algorithm: %s", name)
+	}
+
+	return algo, nil
+}
+
+type ethSecp256k1Algo struct{}
+
+// Name implements keyring.SignatureAlgo
+func (s ethSecp256k1Algo) Name() hd.PubKeyType {
+	return EthSecp256k1Type
+}
+
+// Derive implements keyring.SignatureAlgo.
+// The derivation follows BIP-32, exactly like the secp256k1 algorithm.
+func (s ethSecp256k1Algo) Derive() hd.DeriveFn {
+	return hd.Secp256k1.Derive()
+}
+
+// Generate implements keyring.SignatureAlgo
+func (s ethSecp256k1Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		bzArr := make([]byte, ethsecp256k1.PrivKeySize)
+		copy(bzArr, bz)
+		return &ethsecp256k1.PrivKey{Key: bzArr}
+	}
+}
+
+type ed25519Algo struct{}
+
+// Name implements keyring.SignatureAlgo
+func (s ed25519Algo) Name() hd.PubKeyType {
+	return hd.Ed25519Type
+}
+
+// Derive implements keyring.SignatureAlgo.
+// The derivation follows SLIP-10, which only supports hardened paths (e.g. m/44'/118'/0'/0'/0').
+func (s ed25519Algo) Derive() hd.DeriveFn {
+	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		return deriveEd25519(seed, hdPath)
+	}
+}
+
+// Generate implements keyring.SignatureAlgo
+func (s ed25519Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		return &sdked25519.PrivKey{Key: ed25519.NewKeyFromSeed(bz)}
+	}
+}
+
+// deriveEd25519 derives the ed25519 private key seed from the given BIP-39 seed following the SLIP-10 specification
+func deriveEd25519(seed []byte, path string) ([]byte, error) {
+	mac := hmac.New(sha512.New, []byte("ed25519 seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := sum[:32], sum[32:]
+
+	segments := strings.Split(strings.TrimSpace(path), "/")
+	if segments[0] != "m" {
+		return nil, fmt.Errorf("invalid HD path %s: it must start with m", path)
+	}
+
+	for _, segment := range segments[1:] {
+		if !strings.HasSuffix(segment, "'") {
+			return nil, fmt.Errorf("invalid HD path %s: ed25519 only supports hardened derivation", path)
+		}
+
+		index, err := strconv.ParseUint(strings.TrimSuffix(segment, "'"), 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid HD path %s: %s", path, err)
+		}
+
+		data := make([]byte, 37)
+		copy(data[1:33], key)
+		binary.BigEndian.PutUint32(data[33:], uint32(index)|0x80000000)
+
+		mac = hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum = mac.Sum(nil)
+		key, chainCode = sum[:32], sum[32:]
+	}
+
+	return key, nil
+}
@@ -0,0 +1,90 @@
+package hd
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+func TestAlgoTestSuite(t *testing.T) {
+	suite.Run(t, new(AlgoTestSuite))
+}
+
+type AlgoTestSuite struct {
+	suite.Suite
+}
+
+func (suite *AlgoTestSuite) TestEthSecp256k1Derivation() {
+	mnemonic := "test test test test test test test test test test test junk"
+	derived, err := EthSecp256k1.Derive()(mnemonic, "", "m/44'/60'/0'/0/0")
+	suite.Require().NoError(err)
+
+	privKey := EthSecp256k1.Generate()(derived)
+	suite.Require().Equal("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", hex.EncodeToString(privKey.Bytes()))
+	suite.Require().Equal("f39fd6e51aad88f6f4ce6ab8827279cfffb92266", hex.EncodeToString(privKey.PubKey().Address()))
+}
+
+func (suite *AlgoTestSuite) TestEd25519Derivation() {
+	// Test vector 1 of SLIP-10 for ed25519
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		path      string
+		shouldErr bool
+		expected  string
+	}{
+		{
+			name:     "master key",
+			path:     "m",
+			expected: "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+		},
+		{
+			name:     "hardened child",
+			path:     "m/0'",
+			expected: "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+		},
+		{
+			name:      "non hardened child returns error",
+			path:      "m/44'/118'/0'/0/0",
+			shouldErr: true,
+		},
+		{
+			name:      "invalid path returns error",
+			path:      "44'/118'",
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			key, err := deriveEd25519(seed, tc.path)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expected, hex.EncodeToString(key))
+			}
+		})
+	}
+}
+
+func (suite *AlgoTestSuite) TestGetAlgorithm() {
+	algo, err := GetAlgorithm("")
+	suite.Require().NoError(err)
+	suite.Require().Equal("secp256k1", string(algo.Name()))
+
+	algo, err = GetAlgorithm("eth_secp256k1")
+	suite.Require().NoError(err)
+	suite.Require().Equal(EthSecp256k1, algo)
+
+	algo, err = GetAlgorithm("ed25519")
+	suite.Require().NoError(err)
+	suite.Require().Equal(Ed25519, algo)
+
+	_, err = GetAlgorithm("sr25519")
+	suite.Require().Error(err)
+}
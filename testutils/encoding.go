@@ -34,6 +34,8 @@ import (
 	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
+
+	"github.com/desmos-labs/cosmos-go-wallet/crypto/ethsecp256k1"
 )
 
 type EncodingConfig struct {
@@ -87,6 +89,7 @@ func MakeTestEncodingConfig() EncodingConfig {
 
 	std.RegisterLegacyAminoCodec(encodingConfig.Amino)
 	std.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	ethsecp256k1.RegisterInterfaces(encodingConfig.InterfaceRegistry)
 	moduleBasics.RegisterLegacyAminoCodec(encodingConfig.Amino)
 	moduleBasics.RegisterInterfaces(encodingConfig.InterfaceRegistry)
 
@@ -0,0 +1,158 @@
+package testutils
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockAuthClient is an authtypes.QueryClient that fails the Account calls with the given errors before
+// returning an account with the given account number and sequence
+type MockAuthClient struct {
+	authtypes.QueryClient
+
+	Calls         int
+	Errors        []error
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+func (m *MockAuthClient) Account(_ context.Context, req *authtypes.QueryAccountRequest, _ ...grpc.CallOption) (*authtypes.QueryAccountResponse, error) {
+	m.Calls++
+	if m.Calls <= len(m.Errors) {
+		return nil, m.Errors[m.Calls-1]
+	}
+
+	account, err := codectypes.NewAnyWithValue(&authtypes.BaseAccount{
+		Address:       req.Address,
+		AccountNumber: m.AccountNumber,
+		Sequence:      m.Sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &authtypes.QueryAccountResponse{Account: account}, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// MockTxClient is a sdktx.ServiceClient that simulates transactions using the given amount of gas, and that
+// returns the transactions after a given number of GetTx calls
+type MockTxClient struct {
+	sdktx.ServiceClient
+
+	// Calls is the number of GetTx calls performed
+	Calls    int
+	FoundAt  int
+	GetTxErr error
+
+	// Simulations is the number of Simulate calls performed
+	Simulations int
+	GasUsed     uint64
+	SimulateErr error
+	SimulateRes *sdktx.SimulateResponse
+}
+
+func (m *MockTxClient) Simulate(_ context.Context, _ *sdktx.SimulateRequest, _ ...grpc.CallOption) (*sdktx.SimulateResponse, error) {
+	m.Simulations++
+	if m.SimulateErr != nil {
+		return nil, m.SimulateErr
+	}
+	if m.SimulateRes != nil {
+		return m.SimulateRes, nil
+	}
+
+	gasUsed := m.GasUsed
+	if gasUsed == 0 {
+		gasUsed = 100_000
+	}
+	return &sdktx.SimulateResponse{GasInfo: &sdk.GasInfo{GasUsed: gasUsed}, Result: &sdk.Result{}}, nil
+}
+
+func (m *MockTxClient) GetTx(_ context.Context, req *sdktx.GetTxRequest, _ ...grpc.CallOption) (*sdktx.GetTxResponse, error) {
+	m.Calls++
+	if m.GetTxErr != nil {
+		return nil, m.GetTxErr
+	}
+
+	if m.FoundAt == 0 || m.Calls < m.FoundAt {
+		return nil, status.Errorf(codes.NotFound, "tx not found: %s", req.Hash)
+	}
+
+	return &sdktx.GetTxResponse{TxResponse: &sdk.TxResponse{TxHash: req.Hash, Height: 10}}, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// MockBankClient is a banktypes.QueryClient that returns the given balances, recording the queried addresses
+type MockBankClient struct {
+	banktypes.QueryClient
+
+	Addresses []string
+	Balances  sdk.Coins
+}
+
+func (m *MockBankClient) Balance(_ context.Context, req *banktypes.QueryBalanceRequest, _ ...grpc.CallOption) (*banktypes.QueryBalanceResponse, error) {
+	m.Addresses = append(m.Addresses, req.Address)
+	balance := sdk.NewCoin(req.Denom, m.Balances.AmountOf(req.Denom))
+	return &banktypes.QueryBalanceResponse{Balance: &balance}, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// MockRPCClient is a rpcclient.Client that returns the given node info and latest block height, and the given
+// results when broadcasting transactions in sync mode
+type MockRPCClient struct {
+	rpcclient.Client
+
+	// Calls is the number of BroadcastTxSync calls performed, and Txs contains the broadcast transactions
+	Calls   int
+	Txs     []tmtypes.Tx
+	Results []*coretypes.ResultBroadcastTx
+	Errors  []error
+
+	// StatusCalls is the number of Status calls performed
+	StatusCalls int
+	StatusErr   error
+	Network     string
+
+	// Height is the latest block height, increased by HeightIncrement after each Status call
+	Height          int64
+	HeightIncrement int64
+}
+
+func (m *MockRPCClient) Status(_ context.Context) (*coretypes.ResultStatus, error) {
+	m.StatusCalls++
+	if m.StatusErr != nil {
+		return nil, m.StatusErr
+	}
+
+	height := m.Height
+	m.Height += m.HeightIncrement
+	return &coretypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{Network: m.Network, Version: "0.37.2"},
+		SyncInfo: coretypes.SyncInfo{LatestBlockHeight: height},
+	}, nil
+}
+
+func (m *MockRPCClient) ABCIInfo(_ context.Context) (*coretypes.ResultABCIInfo, error) {
+	return &coretypes.ResultABCIInfo{Response: abci.ResponseInfo{Data: "desmos", Version: "5.2.0", AppVersion: 1}}, nil
+}
+
+func (m *MockRPCClient) BroadcastTxSync(_ context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	m.Calls++
+	m.Txs = append(m.Txs, tx)
+	return m.Results[m.Calls-1], m.Errors[m.Calls-1]
+}
@@ -1,14 +1,106 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 type ChainConfig struct {
-	Bech32Prefix  string  `toml:"bech32_prefix" yaml:"bech32_prefix"`
-	RPCAddr       string  `toml:"rpc_addr" yaml:"rpc_addr"`
-	GRPCAddr      string  `toml:"grpc_addr" yaml:"grpc_addr"`
+	// ChainID, if set, is used instead of the chain id returned by the node
+	ChainID string `toml:"chain_id" yaml:"chain_id"`
+
+	Bech32Prefix string `toml:"bech32_prefix" yaml:"bech32_prefix"`
+	RPCAddr      string `toml:"rpc_addr" yaml:"rpc_addr"`
+	GRPCAddr     string `toml:"grpc_addr" yaml:"grpc_addr"`
+
+	// RPCAddrs and GRPCAddrs contain additional endpoints that are used when the other ones are not available.
+	// RPCAddr and GRPCAddr, if set, are considered to be the first endpoints of the lists.
+	RPCAddrs  []string `toml:"rpc_addrs" yaml:"rpc_addrs"`
+	GRPCAddrs []string `toml:"grpc_addrs" yaml:"grpc_addrs"`
+
+	// GasPrice contains the comma separated gas prices that can be used to pay fees (e.g. 0.01udaric,0.02uatom),
+	// in order of preference
 	GasPrice      string  `toml:"gas_price" yaml:"gas_price"`
 	GasAdjustment float64 `toml:"gas_adjustment" yaml:"gas_adjustment"`
+
+	// MaxGas, MaxFee and MaxGasPrice limit the gas and fees that a transaction can use before being signed
+	// (e.g. max_fee = "1000000udaric", max_gas_price = "0.1udaric"). Empty values mean that no limit is applied.
+	MaxGas      uint64 `toml:"max_gas" yaml:"max_gas"`
+	MaxFee      string `toml:"max_fee" yaml:"max_fee"`
+	MaxGasPrice string `toml:"max_gas_price" yaml:"max_gas_price"`
+}
+
+// GetRPCAddrs returns all the configured RPC endpoints, in order of preference
+func (c *ChainConfig) GetRPCAddrs() []string {
+	return mergeAddrs(c.RPCAddr, c.RPCAddrs)
+}
+
+// GetGRPCAddrs returns all the configured gRPC endpoints, in order of preference
+func (c *ChainConfig) GetGRPCAddrs() []string {
+	return mergeAddrs(c.GRPCAddr, c.GRPCAddrs)
+}
+
+// GetGasPrices parses and returns all the configured gas prices, in order of preference
+func (c *ChainConfig) GetGasPrices() ([]sdk.DecCoin, error) {
+	var gasPrices []sdk.DecCoin
+	seen := map[string]bool{}
+	for _, value := range strings.Split(c.GasPrice, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		gasPrice, err := sdk.ParseDecCoin(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[gasPrice.Denom] {
+			return nil, fmt.Errorf("duplicated gas price denom: %s", gasPrice.Denom)
+		}
+		seen[gasPrice.Denom] = true
+		gasPrices = append(gasPrices, gasPrice)
+	}
+
+	if len(gasPrices) == 0 {
+		return nil, fmt.Errorf("at least one gas price must be provided")
+	}
+
+	return gasPrices, nil
+}
+
+// mergeAddrs returns the list of the non-empty given addresses, without duplicates
+func mergeAddrs(addr string, addrs []string) []string {
+	var merged []string
+	seen := map[string]bool{}
+	for _, address := range append([]string{addr}, addrs...) {
+		address = strings.TrimSpace(address)
+		if address == "" || seen[address] {
+			continue
+		}
+		seen[address] = true
+		merged = append(merged, address)
+	}
+	return merged
 }
 
 type AccountConfig struct {
 	Mnemonic string `toml:"mnemonic" yaml:"mnemonic"`
 	HDPath   string `toml:"hd_path" yaml:"hd_path"`
+
+	// KeyAlgorithm is the algorithm of the account key (secp256k1, eth_secp256k1 or ed25519).
+	// If empty, secp256k1 is used.
+	KeyAlgorithm string `toml:"key_algorithm" yaml:"key_algorithm"`
+
+	// KeyringBackend, KeyringDir and KeyName allow to sign using a key stored inside a
+	// Cosmos SDK keyring instead of the mnemonic
+	KeyringBackend string `toml:"keyring_backend" yaml:"keyring_backend"`
+	KeyringDir     string `toml:"keyring_dir" yaml:"keyring_dir"`
+	KeyName        string `toml:"key_name" yaml:"key_name"`
+
+	// KeyringPassphraseEnv is the name of the environment variable containing the passphrase used to unlock
+	// the keyring when using the file backend
+	KeyringPassphraseEnv string `toml:"keyring_passphrase_env" yaml:"keyring_passphrase_env"`
 }
@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
 
 // TransactionData contains all the data about a transaction
 type TransactionData struct {
@@ -10,8 +15,30 @@ type TransactionData struct {
 	GasAuto    bool
 	FeeAmount  sdk.Coins
 	FeeAuto    bool
+	FeeDenom   string
 	FeeGranter sdk.AccAddress
+	FeePayer   sdk.AccAddress
 	Sequence   *uint64
+	SignMode   signing.SignMode
+
+	AccountNumber *uint64
+	ChainID       string
+
+	SequenceRetries uint
+	DryRun          bool
+
+	// TimeoutHeight is the block height after which the transaction is not valid anymore, while TimeoutBlocks
+	// is the number of blocks after the latest one after which the transaction is not valid anymore
+	TimeoutHeight uint64
+	TimeoutBlocks uint64
+
+	ExtensionOptions            []*codectypes.Any
+	NonCriticalExtensionOptions []*codectypes.Any
+
+	// Tip is the tip paid by the auxiliary signer building the transaction, while AuxSignerData contains
+	// the data signed by the auxiliary signers that should be included inside the transaction
+	Tip           sdk.Coins
+	AuxSignerData []sdktx.AuxSignerData
 }
 
 // NewTransactionData builds a new TransactionData instance
@@ -51,6 +78,13 @@ func (t *TransactionData) WithFeeAuto() *TransactionData {
 	return t
 }
 
+// WithFeeDenom allows to set the denom that should be used to pay fees when they are computed automatically.
+// If not set and multiple gas prices are configured, the first denom the fee payer has enough balance of is used.
+func (t *TransactionData) WithFeeDenom(denom string) *TransactionData {
+	t.FeeDenom = denom
+	return t
+}
+
 // WithFeeGranter allows to set the given fee granter that will pay for fees.
 // To work properly, a fee grant must exist from the granter towards the transaction signer.
 func (t *TransactionData) WithFeeGranter(granter sdk.AccAddress) *TransactionData {
@@ -58,8 +92,91 @@ func (t *TransactionData) WithFeeGranter(granter sdk.AccAddress) *TransactionDat
 	return t
 }
 
+// WithFeePayer allows to set the account that will pay for fees.
+// If not set, the first signer pays for fees, unless auxiliary signer data are set, in which case the
+// wallet building the transaction pays for fees.
+func (t *TransactionData) WithFeePayer(feePayer sdk.AccAddress) *TransactionData {
+	t.FeePayer = feePayer
+	return t
+}
+
 // WithSequence allows to set the given sequence
 func (t *TransactionData) WithSequence(sequence uint64) *TransactionData {
 	t.Sequence = &sequence
 	return t
 }
+
+// WithAccountNumber allows to set the given account number
+func (t *TransactionData) WithAccountNumber(accountNumber uint64) *TransactionData {
+	t.AccountNumber = &accountNumber
+	return t
+}
+
+// WithChainID allows to set the chain id the transaction should be signed for
+func (t *TransactionData) WithChainID(chainID string) *TransactionData {
+	t.ChainID = chainID
+	return t
+}
+
+// WithSignMode allows to set the sign mode that should be used when signing the transaction.
+// If not set, SIGN_MODE_DIRECT is used.
+func (t *TransactionData) WithSignMode(signMode signing.SignMode) *TransactionData {
+	t.SignMode = signMode
+	return t
+}
+
+// WithSequenceRetries allows to set the maximum number of times the transaction should be built, signed and
+// broadcast again when the chain rejects it due to an account sequence mismatch
+func (t *TransactionData) WithSequenceRetries(retries uint) *TransactionData {
+	t.SequenceRetries = retries
+	return t
+}
+
+// WithDryRun allows to build, simulate and sign the transaction without broadcasting it.
+// The broadcast methods return a synthetic result containing the transaction hash computed locally.
+func (t *TransactionData) WithDryRun() *TransactionData {
+	t.DryRun = true
+	return t
+}
+
+// WithTimeoutHeight allows to set the block height after which the transaction cannot be included
+// inside a block anymore
+func (t *TransactionData) WithTimeoutHeight(height uint64) *TransactionData {
+	t.TimeoutHeight = height
+	return t
+}
+
+// WithTimeoutBlocks allows to set the number of blocks, after the latest one, after which the transaction
+// cannot be included inside a block anymore. It is ignored if a timeout height is set.
+func (t *TransactionData) WithTimeoutBlocks(blocks uint64) *TransactionData {
+	t.TimeoutBlocks = blocks
+	return t
+}
+
+// WithExtensionOptions allows to set the extension options of the transaction (e.g. ExtensionOptionsWeb3Tx).
+// The TxConfig used to build the transaction must support them.
+func (t *TransactionData) WithExtensionOptions(options ...*codectypes.Any) *TransactionData {
+	t.ExtensionOptions = options
+	return t
+}
+
+// WithNonCriticalExtensionOptions allows to set the non-critical extension options of the transaction.
+// The TxConfig used to build the transaction must support them.
+func (t *TransactionData) WithNonCriticalExtensionOptions(options ...*codectypes.Any) *TransactionData {
+	t.NonCriticalExtensionOptions = options
+	return t
+}
+
+// WithTip allows to set the tip that is paid to the fee payer when building auxiliary signer data
+// using Wallet#BuildAuxSignerData
+func (t *TransactionData) WithTip(tip sdk.Coins) *TransactionData {
+	t.Tip = tip
+	return t
+}
+
+// WithAuxSignerData allows to include the given data signed by auxiliary signers (e.g. a tipper) inside the
+// transaction. The messages, memo and timeout height of the transaction are the ones signed by the auxiliary signers.
+func (t *TransactionData) WithAuxSignerData(data ...sdktx.AuxSignerData) *TransactionData {
+	t.AuxSignerData = data
+	return t
+}
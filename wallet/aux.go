@@ -0,0 +1,121 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdkclienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+)
+
+// BuildAuxSignerData builds and signs the data of a transaction that should be included inside the transaction
+// built by another wallet, which will pay for fees. It allows this wallet to act as a tipper, paying the given
+// tip to the fee payer in exchange for the fees, possibly using a different denom.
+// The data is signed using SIGN_MODE_DIRECT_AUX, unless SIGN_MODE_LEGACY_AMINO_JSON is specified.
+func (w *Wallet) BuildAuxSignerData(ctx context.Context, data *types.TransactionData) (sdktx.AuxSignerData, error) {
+	if len(data.Messages) == 0 {
+		return sdktx.AuxSignerData{}, fmt.Errorf("error while building a transaction with no messages")
+	}
+
+	signMode := data.SignMode
+	switch signMode {
+	case signing.SignMode_SIGN_MODE_UNSPECIFIED:
+		signMode = signing.SignMode_SIGN_MODE_DIRECT_AUX
+	case signing.SignMode_SIGN_MODE_DIRECT_AUX, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+	default:
+		return sdktx.AuxSignerData{}, fmt.Errorf("unsupported auxiliary sign mode: %s", signMode)
+	}
+
+	accountNumber, sequence, err := w.getAccountData(ctx, data)
+	if err != nil {
+		return sdktx.AuxSignerData{}, err
+	}
+
+	chainID := data.ChainID
+	if chainID == "" {
+		if w.Client == nil {
+			return sdktx.AuxSignerData{}, fmt.Errorf("the chain id must be set when building a transaction offline")
+		}
+
+		chainID, err = w.Client.GetChainIDCtx(ctx)
+		if err != nil {
+			return sdktx.AuxSignerData{}, err
+		}
+	}
+
+	timeoutHeight, err := getTimeoutHeight(ctx, w.Client, data)
+	if err != nil {
+		return sdktx.AuxSignerData{}, err
+	}
+
+	builder := sdkclienttx.NewAuxTxBuilder()
+	builder.SetAddress(w.AccAddress())
+	builder.SetAccountNumber(accountNumber)
+	builder.SetSequence(sequence)
+	builder.SetChainID(chainID)
+	builder.SetMemo(data.Memo)
+	builder.SetTimeoutHeight(timeoutHeight)
+	builder.SetExtensionOptions(data.ExtensionOptions...)
+	builder.SetNonCriticalExtensionOptions(data.NonCriticalExtensionOptions...)
+
+	err = builder.SetMsgs(data.Messages...)
+	if err != nil {
+		return sdktx.AuxSignerData{}, err
+	}
+
+	if !data.Tip.Empty() {
+		builder.SetTip(&sdktx.Tip{Amount: data.Tip, Tipper: w.AccAddress()})
+	}
+
+	err = builder.SetPubKey(w.signer.PubKey())
+	if err != nil {
+		return sdktx.AuxSignerData{}, err
+	}
+
+	err = builder.SetSignMode(signMode)
+	if err != nil {
+		return sdktx.AuxSignerData{}, err
+	}
+
+	signBytes, err := builder.GetSignBytes()
+	if err != nil {
+		return sdktx.AuxSignerData{}, fmt.Errorf("error while getting sign bytes: %s", err)
+	}
+
+	signature, err := w.signer.Sign(signBytes, signMode)
+	if err != nil {
+		return sdktx.AuxSignerData{}, fmt.Errorf("error while signing tx: %s", err)
+	}
+	builder.SetSignature(signature)
+
+	return builder.GetAuxSignerData()
+}
+
+// addAuxSignerData adds the auxiliary signer data contained inside the given transaction data to the given builder
+func addAuxSignerData(builder sdkclient.TxBuilder, data *types.TransactionData) error {
+	for _, auxSignerData := range data.AuxSignerData {
+		err := builder.AddAuxSignerData(auxSignerData)
+		if err != nil {
+			return fmt.Errorf("error while adding auxiliary signer data: %s", err)
+		}
+	}
+	return nil
+}
+
+// withAuxFeePayer returns the given data setting the given address as the fee payer, if the transaction
+// contains auxiliary signer data and no fee payer has been set
+func withAuxFeePayer(data *types.TransactionData, feePayer sdk.AccAddress) *types.TransactionData {
+	if len(data.AuxSignerData) == 0 || data.FeePayer != nil {
+		return data
+	}
+
+	// Copy the data so that the one provided by the caller is not changed
+	txData := *data
+	txData.FeePayer = feePayer
+	return &txData
+}
@@ -0,0 +1,96 @@
+package wallet_test
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+	"github.com/desmos-labs/cosmos-go-wallet/wallet"
+)
+
+func (suite *WalletTestSuite) TestAuxSignerData() {
+	// Use new keys so that their addresses have never been cached by sdk.AccAddress#String using a different prefix
+	feePayerSigner := wallet.NewPrivKeySigner(secp256k1.GenPrivKey())
+	feePayer := wallet.NewOfflineWallet(feePayerSigner, "desmos", suite.encodingCfg.TxConfig)
+
+	testCases := []struct {
+		name     string
+		signMode signing.SignMode
+	}{
+		{
+			name:     "direct aux sign mode",
+			signMode: signing.SignMode_SIGN_MODE_DIRECT_AUX,
+		},
+		{
+			name:     "amino JSON sign mode",
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			tipper := wallet.NewOfflineWallet(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), "desmos", suite.encodingCfg.TxConfig)
+			tip := sdk.NewCoins(sdk.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 100))
+
+			auxSignerData, err := tipper.BuildAuxSignerData(context.Background(), types.NewTransactionData(
+				newTestMsgSend(tipper.AccAddress()),
+			).
+				WithMemo("Tipped transaction").
+				WithTip(tip).
+				WithSignMode(tc.signMode).
+				WithAccountNumber(20).
+				WithSequence(5).
+				WithChainID("morpheus-apollo-3"))
+			suite.Require().NoError(err)
+
+			builder, err := feePayer.BuildTxCtx(context.Background(), types.NewTransactionData().
+				WithAuxSignerData(auxSignerData).
+				WithGasLimit(200_000).
+				WithFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("udaric", 2_000))).
+				WithAccountNumber(10).
+				WithSequence(1).
+				WithChainID("morpheus-apollo-3"))
+			suite.Require().NoError(err)
+
+			tx := builder.GetTx()
+			suite.Require().Equal("Tipped transaction", tx.GetMemo())
+			suite.Require().Equal(tip, tx.GetTip().Amount)
+			suite.Require().Equal(tipper.AccAddress(), tx.GetTip().Tipper)
+			suite.Require().Equal(feePayerSigner.PubKey().Address().Bytes(), tx.FeePayer().Bytes())
+
+			// Make sure both signatures are valid
+			sigs, err := tx.GetSignaturesV2()
+			suite.Require().NoError(err)
+			suite.Require().Len(sigs, 2)
+
+			signersData := []authsigning.SignerData{
+				{Address: tipper.AccAddress(), ChainID: "morpheus-apollo-3", AccountNumber: 20, Sequence: 5, PubKey: sigs[0].PubKey},
+				{Address: feePayer.AccAddress(), ChainID: "morpheus-apollo-3", AccountNumber: 10, Sequence: 1, PubKey: sigs[1].PubKey},
+			}
+			for i, sig := range sigs {
+				err = authsigning.VerifySignature(sig.PubKey, signersData[i], sig.Data, suite.encodingCfg.TxConfig.SignModeHandler(), tx)
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *WalletTestSuite) TestTipWithoutAuxSignerData() {
+	w := suite.newTestWallet(nil)
+
+	_, err := w.BuildTxCtx(context.Background(), types.NewTransactionData(
+		newTestMsgSend(w.AccAddress()),
+	).
+		WithTip(sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))).
+		WithGasLimit(200_000).
+		WithFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("udaric", 2_000))).
+		WithAccountNumber(10).
+		WithSequence(1).
+		WithChainID("morpheus-apollo-3"))
+	suite.Require().Error(err)
+}
@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+
+	"github.com/desmos-labs/cosmos-go-wallet/client"
+	"github.com/desmos-labs/cosmos-go-wallet/crypto/hd"
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+)
+
+const (
+	// KeyringAppName is the application name used when opening a keyring
+	KeyringAppName = "cosmos-go-wallet"
+)
+
+// NewKeyring opens the keyring described by the given account config.
+// In order to read keys of algorithms other than secp256k1, their types must be registered inside the given codec.
+// The passphrase is used to unlock the keyring when using the file backend, and it is required in that case since
+// the standard input is never read.
+// When using the memory backend, the key is imported from the config mnemonic if it does not exist yet.
+func NewKeyring(accountCfg *types.AccountConfig, passphrase string, cdc codec.Codec) (keyring.Keyring, error) {
+	if accountCfg.KeyringBackend == keyring.BackendFile && passphrase == "" {
+		return nil, fmt.Errorf("a passphrase is required to open the file keyring")
+	}
+
+	// The passphrase is repeated since it needs to be confirmed when creating a new keyring
+	userInput := strings.NewReader(passphrase + "\n" + passphrase + "\n")
+
+	algo, err := hd.GetAlgorithm(accountCfg.KeyAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	kr, err := keyring.New(KeyringAppName, accountCfg.KeyringBackend, accountCfg.KeyringDir, userInput, cdc,
+		func(options *keyring.Options) {
+			options.SupportedAlgos = hd.SupportedAlgorithms
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while opening keyring: %w", err)
+	}
+
+	if accountCfg.KeyringBackend == keyring.BackendMemory && accountCfg.Mnemonic != "" {
+		_, err = kr.Key(accountCfg.KeyName)
+		if err != nil {
+			_, err = kr.NewAccount(accountCfg.KeyName, accountCfg.Mnemonic, "", accountCfg.HDPath, algo)
+			if err != nil {
+				return nil, fmt.Errorf("error while importing key into keyring: %w", err)
+			}
+		}
+	}
+
+	return kr, nil
+}
+
+// NewKeyringWallet allows to build a new Wallet instance that signs transactions using the key stored inside
+// the keyring described by the given account config. See NewKeyring for details about the passphrase.
+func NewKeyringWallet(accountCfg *types.AccountConfig, passphrase string, client *client.Client, txConfig sdkclient.TxConfig) (*Wallet, error) {
+	kr, err := NewKeyring(accountCfg, passphrase, client.Codec)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := NewKeyringSigner(kr, accountCfg.KeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWalletFromSigner(signer, client, txConfig), nil
+}
+
+// getKeyringPassphrase returns the passphrase that should be used to unlock the keyring described by the given
+// account config, reading it from the environment variable set inside the config.
+// An error is returned if the file backend is used and no passphrase is available, since it would otherwise
+// be read from the standard input.
+func getKeyringPassphrase(accountCfg *types.AccountConfig) (string, error) {
+	var passphrase string
+	if accountCfg.KeyringPassphraseEnv != "" {
+		passphrase = os.Getenv(accountCfg.KeyringPassphraseEnv)
+	}
+
+	if accountCfg.KeyringBackend == keyring.BackendFile && passphrase == "" {
+		return "", fmt.Errorf("a passphrase is required to open the file keyring: set the keyring passphrase " +
+			"environment variable inside the account config, or use NewKeyringWallet to provide it")
+	}
+
+	return passphrase, nil
+}
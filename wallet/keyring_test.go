@@ -0,0 +1,183 @@
+package wallet_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/desmos-labs/cosmos-go-wallet/client"
+	"github.com/desmos-labs/cosmos-go-wallet/testutils"
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+	"github.com/desmos-labs/cosmos-go-wallet/wallet"
+)
+
+func TestKeyringTestSuite(t *testing.T) {
+	suite.Run(t, new(KeyringTestSuite))
+}
+
+type KeyringTestSuite struct {
+	suite.Suite
+
+	encodingCfg testutils.EncodingConfig
+	client      *client.Client
+	address     string
+}
+
+func (suite *KeyringTestSuite) SetupSuite() {
+	suite.encodingCfg = testutils.MakeTestEncodingConfig()
+
+	c, err := client.NewClient(&types.ChainConfig{
+		Bech32Prefix: "desmos",
+		RPCAddr:      "http://localhost:26657",
+		GRPCAddr:     "http://localhost:9090",
+		GasPrice:     "0.01udaric",
+	}, suite.encodingCfg.Codec)
+	suite.Require().NoError(err)
+	suite.client = c
+
+	w, err := wallet.NewWallet(&types.AccountConfig{Mnemonic: testMnemonic, HDPath: testHDPath}, c, suite.encodingCfg.TxConfig)
+	suite.Require().NoError(err)
+	suite.address = w.AccAddress()
+}
+
+func (suite *KeyringTestSuite) TestNewKeyringWallet() {
+	testCases := []struct {
+		name       string
+		setup      func(dir string)
+		accountCfg func(dir string) *types.AccountConfig
+		passphrase string
+		shouldErr  bool
+		expAddress string
+	}{
+		{
+			name: "test backend",
+			setup: func(dir string) {
+				kr, err := keyring.New(wallet.KeyringAppName, keyring.BackendTest, dir, nil, suite.encodingCfg.Codec)
+				suite.Require().NoError(err)
+				_, err = kr.NewAccount("key", testMnemonic, "", testHDPath, hd.Secp256k1)
+				suite.Require().NoError(err)
+			},
+			accountCfg: func(dir string) *types.AccountConfig {
+				return &types.AccountConfig{KeyringBackend: keyring.BackendTest, KeyringDir: dir, KeyName: "key"}
+			},
+		},
+		{
+			name: "file backend with passphrase",
+			setup: func(dir string) {
+				input := strings.NewReader("passphrase\npassphrase\n")
+				kr, err := keyring.New(wallet.KeyringAppName, keyring.BackendFile, dir, input, suite.encodingCfg.Codec)
+				suite.Require().NoError(err)
+				_, err = kr.NewAccount("key", testMnemonic, "", testHDPath, hd.Secp256k1)
+				suite.Require().NoError(err)
+			},
+			accountCfg: func(dir string) *types.AccountConfig {
+				return &types.AccountConfig{KeyringBackend: keyring.BackendFile, KeyringDir: dir, KeyName: "key"}
+			},
+			passphrase: "passphrase",
+		},
+		{
+			name: "file backend without passphrase returns error",
+			setup: func(dir string) {
+				input := strings.NewReader("passphrase\npassphrase\n")
+				kr, err := keyring.New(wallet.KeyringAppName, keyring.BackendFile, dir, input, suite.encodingCfg.Codec)
+				suite.Require().NoError(err)
+				_, err = kr.NewAccount("key", testMnemonic, "", testHDPath, hd.Secp256k1)
+				suite.Require().NoError(err)
+			},
+			accountCfg: func(dir string) *types.AccountConfig {
+				return &types.AccountConfig{KeyringBackend: keyring.BackendFile, KeyringDir: dir, KeyName: "key"}
+			},
+			shouldErr: true,
+		},
+		{
+			name: "memory backend imports the mnemonic",
+			accountCfg: func(dir string) *types.AccountConfig {
+				return &types.AccountConfig{
+					Mnemonic:       testMnemonic,
+					HDPath:         testHDPath,
+					KeyringBackend: keyring.BackendMemory,
+					KeyName:        "key",
+				}
+			},
+		},
+		{
+			name: "memory backend with eth_secp256k1 key",
+			accountCfg: func(dir string) *types.AccountConfig {
+				return &types.AccountConfig{
+					Mnemonic:       "test test test test test test test test test test test junk",
+					HDPath:         "m/44'/60'/0'/0/0",
+					KeyAlgorithm:   "eth_secp256k1",
+					KeyringBackend: keyring.BackendMemory,
+					KeyName:        "key",
+				}
+			},
+			expAddress: "desmos17w0adeg64ky0daxwd2ugyuneellmjgnxh9kzuc",
+		},
+		{
+			name: "missing key returns error",
+			accountCfg: func(dir string) *types.AccountConfig {
+				return &types.AccountConfig{KeyringBackend: keyring.BackendTest, KeyringDir: dir, KeyName: "key"}
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid backend returns error",
+			accountCfg: func(dir string) *types.AccountConfig {
+				return &types.AccountConfig{KeyringBackend: "invalid", KeyringDir: dir, KeyName: "key"}
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			dir := suite.T().TempDir()
+			if tc.setup != nil {
+				tc.setup(dir)
+			}
+
+			w, err := wallet.NewKeyringWallet(tc.accountCfg(dir), tc.passphrase, suite.client, suite.encodingCfg.TxConfig)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			expAddress := suite.address
+			if tc.expAddress != "" {
+				expAddress = tc.expAddress
+			}
+			suite.Require().Equal(expAddress, w.AccAddress())
+		})
+	}
+}
+
+func (suite *KeyringTestSuite) TestNewWalletFileKeyring() {
+	dir := suite.T().TempDir()
+	input := strings.NewReader("passphrase\npassphrase\n")
+	kr, err := keyring.New(wallet.KeyringAppName, keyring.BackendFile, dir, input, suite.encodingCfg.Codec)
+	suite.Require().NoError(err)
+	_, err = kr.NewAccount("key", testMnemonic, "", testHDPath, hd.Secp256k1)
+	suite.Require().NoError(err)
+
+	accountCfg := &types.AccountConfig{
+		KeyringBackend:       keyring.BackendFile,
+		KeyringDir:           dir,
+		KeyName:              "key",
+		KeyringPassphraseEnv: "TEST_KEYRING_PASSPHRASE",
+	}
+
+	// The passphrase must not be read from the standard input
+	_, err = wallet.NewWallet(accountCfg, suite.client, suite.encodingCfg.TxConfig)
+	suite.Require().Error(err)
+
+	suite.T().Setenv("TEST_KEYRING_PASSPHRASE", "passphrase")
+	w, err := wallet.NewWallet(accountCfg, suite.client, suite.encodingCfg.TxConfig)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.address, w.AccAddress())
+}
@@ -0,0 +1,216 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/desmos-labs/cosmos-go-wallet/client"
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+)
+
+// MultisigAccount represents an account controlled by a multisig public key.
+// Transactions for such an account are built using BuildUnsignedTx, signed by each member using
+// Wallet.SignMultisigTx, and then combined with CombineSignatures before being broadcast using Client.BroadcastTxCtx.
+// All the signatures are made using SIGN_MODE_LEGACY_AMINO_JSON.
+type MultisigAccount struct {
+	pubKey *kmultisig.LegacyAminoPubKey
+	prefix string
+
+	TxConfig sdkclient.TxConfig
+	Client   *client.Client
+
+	// FeeLimits, if set, is used instead of the client limits to check the gas and fees of the transactions
+	// before returning them. It allows to limit the transactions built offline as well.
+	FeeLimits *client.FeeLimits
+}
+
+// NewMultisigAccount allows to build a new MultisigAccount instance
+func NewMultisigAccount(pubKey *kmultisig.LegacyAminoPubKey, client *client.Client, txConfig sdkclient.TxConfig) *MultisigAccount {
+	return &MultisigAccount{
+		pubKey:   pubKey,
+		prefix:   client.GetAccountPrefix(),
+		TxConfig: txConfig,
+		Client:   client,
+	}
+}
+
+// PubKey returns the multisig public key of the account
+func (m *MultisigAccount) PubKey() *kmultisig.LegacyAminoPubKey {
+	return m.pubKey
+}
+
+// AccAddress returns the address of the multisig account
+func (m *MultisigAccount) AccAddress() string {
+	bech32Addr, err := bech32.ConvertAndEncode(m.prefix, m.pubKey.Address())
+	if err != nil {
+		panic(err)
+	}
+	return bech32Addr
+}
+
+// GetSignerData returns the data that the members of the multisig should use to sign a transaction built
+// using the given data. The values set inside the data are used, while the missing ones are read from the chain.
+func (m *MultisigAccount) GetSignerData(ctx context.Context, data *types.TransactionData) (SignerData, error) {
+	accountNumber, sequence, err := getAccountData(ctx, m.Client, m.AccAddress(), data)
+	if err != nil {
+		return SignerData{}, err
+	}
+
+	chainID := data.ChainID
+	if chainID == "" {
+		if m.Client == nil {
+			return SignerData{}, fmt.Errorf("the chain id must be set when building a transaction offline")
+		}
+
+		chainID, err = m.Client.GetChainIDCtx(ctx)
+		if err != nil {
+			return SignerData{}, err
+		}
+	}
+
+	return SignerData{
+		ChainID:       chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+	}, nil
+}
+
+// BuildUnsignedTx creates a transaction with the provided messages and fees that should be signed by the
+// members of the multisig
+func (m *MultisigAccount) BuildUnsignedTx(ctx context.Context, data *types.TransactionData) (sdkclient.TxBuilder, error) {
+	var sequence uint64
+	if data.GasAuto {
+		// The sequence is required to properly simulate the transaction
+		_, accSequence, err := getAccountData(ctx, m.Client, m.AccAddress(), data)
+		if err != nil {
+			return nil, err
+		}
+		sequence = accSequence
+	}
+
+	data = withAuxFeePayer(data, m.pubKey.Address().Bytes())
+	builder, _, err := buildUnsignedTx(ctx, m.Client, m.TxConfig, data, m.simulationSignature(sequence))
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.getFeeLimits().Check(builder.GetTx().GetGas(), builder.GetTx().GetFee())
+	if err != nil {
+		return nil, err
+	}
+
+	return builder, nil
+}
+
+// getFeeLimits returns the limits that the gas and fees of the transactions must respect
+func (m *MultisigAccount) getFeeLimits() client.FeeLimits {
+	if m.FeeLimits != nil {
+		return *m.FeeLimits
+	}
+	if m.Client != nil {
+		return m.Client.FeeLimits
+	}
+	return client.FeeLimits{}
+}
+
+// CombineSignatures combines the given signatures of the multisig members into a single multisig signature,
+// and sets it inside the given builder. The signatures must have been made using the provided signer data.
+// Each signature is verified before being added, and an error is returned if the signatures of less than
+// threshold distinct members are given.
+func (m *MultisigAccount) CombineSignatures(builder sdkclient.TxBuilder, signerData SignerData, sigs ...signing.SignatureV2) error {
+	pubKeys := m.pubKey.GetPubKeys()
+	multisigData := multisig.NewMultisig(len(pubKeys))
+	for _, sig := range sigs {
+		if sig.Sequence != signerData.Sequence {
+			return fmt.Errorf("invalid signature sequence: expected %d, got %d", signerData.Sequence, sig.Sequence)
+		}
+
+		err := authsigning.VerifySignature(sig.PubKey, authsigning.SignerData{
+			Address:       m.AccAddress(),
+			ChainID:       signerData.ChainID,
+			AccountNumber: signerData.AccountNumber,
+			Sequence:      signerData.Sequence,
+			PubKey:        m.pubKey,
+		}, sig.Data, m.TxConfig.SignModeHandler(), builder.GetTx())
+		if err != nil {
+			return fmt.Errorf("error while verifying signature: %s", err)
+		}
+
+		err = multisig.AddSignatureV2(multisigData, sig, pubKeys)
+		if err != nil {
+			return fmt.Errorf("error while adding signature: %s", err)
+		}
+	}
+
+	// Signatures of the same member replace each other, so the threshold is checked on the combined signature
+	signed := multisigData.BitArray.NumTrueBitsBefore(len(pubKeys))
+	if uint(signed) < m.pubKey.GetThreshold() {
+		return fmt.Errorf("not enough signatures: expected at least %d, got %d", m.pubKey.GetThreshold(), signed)
+	}
+
+	return builder.SetSignatures(signing.SignatureV2{
+		PubKey:   m.pubKey,
+		Data:     multisigData,
+		Sequence: signerData.Sequence,
+	})
+}
+
+// simulationSignature returns the signature that should be set when simulating a transaction that will be signed
+// using the given sequence. Empty signatures are set for the threshold number of members, so that the ante handler
+// can properly compute the gas.
+func (m *MultisigAccount) simulationSignature(sequence uint64) signing.SignatureV2 {
+	pubKeys := m.pubKey.GetPubKeys()
+	multisigData := multisig.NewMultisig(len(pubKeys))
+	for i := 0; i < int(m.pubKey.GetThreshold()); i++ {
+		multisig.AddSignature(multisigData, &signing.SingleSignatureData{
+			SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		}, i)
+	}
+
+	return signing.SignatureV2{
+		PubKey:   m.pubKey,
+		Data:     multisigData,
+		Sequence: sequence,
+	}
+}
+
+// SignMultisigTx signs the transaction contained inside the given builder on behalf of a multisig account,
+// and returns the resulting partial signature without setting it inside the builder.
+// The signer data must be the one of the multisig account, as returned by MultisigAccount.GetSignerData.
+// An error is returned if the gas or fees of the transaction exceed the limits of the wallet.
+func (w *Wallet) SignMultisigTx(multisigPubKey *kmultisig.LegacyAminoPubKey, builder sdkclient.TxBuilder, signerData SignerData) (signing.SignatureV2, error) {
+	isMember := false
+	for _, pubKey := range multisigPubKey.GetPubKeys() {
+		if pubKey.Equals(w.signer.PubKey()) {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		return signing.SignatureV2{}, fmt.Errorf("the wallet key is not a member of the multisig")
+	}
+
+	err := w.getFeeLimits().Check(builder.GetTx().GetGas(), builder.GetTx().GetFee())
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	return w.signTx(
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		authsigning.SignerData{
+			Address:       w.AccAddress(),
+			ChainID:       signerData.ChainID,
+			AccountNumber: signerData.AccountNumber,
+			Sequence:      signerData.Sequence,
+			PubKey:        w.signer.PubKey(),
+		},
+		builder,
+	)
+}
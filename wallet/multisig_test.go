@@ -0,0 +1,262 @@
+package wallet_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/desmos-labs/cosmos-go-wallet/client"
+	"github.com/desmos-labs/cosmos-go-wallet/testutils"
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+	"github.com/desmos-labs/cosmos-go-wallet/wallet"
+)
+
+func TestMultisigTestSuite(t *testing.T) {
+	suite.Run(t, new(MultisigTestSuite))
+}
+
+type MultisigTestSuite struct {
+	suite.Suite
+
+	encodingCfg testutils.EncodingConfig
+	members     []*wallet.Wallet
+	outsider    *wallet.Wallet
+	multisig    *wallet.MultisigAccount
+}
+
+func (suite *MultisigTestSuite) SetupSuite() {
+	chainCfg := types.ChainConfig{
+		Bech32Prefix: "desmos",
+		RPCAddr:      "http://localhost:26657",
+		GRPCAddr:     "http://localhost:9090",
+		GasPrice:     "0.01udaric",
+	}
+
+	// Set up the SDK config with the proper bech32 prefixes
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount(chainCfg.Bech32Prefix, fmt.Sprintf("%spub", chainCfg.Bech32Prefix))
+
+	suite.encodingCfg = testutils.MakeTestEncodingConfig()
+
+	c, err := client.NewClient(&chainCfg, suite.encodingCfg.Codec)
+	suite.Require().NoError(err)
+
+	var pubKeys []cryptotypes.PubKey
+	for i := 0; i < 4; i++ {
+		signer, err := wallet.NewMnemonicSigner(testMnemonic, fmt.Sprintf("m/44'/852'/0'/0/%d", i), hd.Secp256k1)
+		suite.Require().NoError(err)
+
+		w := wallet.NewOfflineWallet(signer, "desmos", suite.encodingCfg.TxConfig)
+		if i == 3 {
+			suite.outsider = w
+			continue
+		}
+
+		suite.members = append(suite.members, w)
+		pubKeys = append(pubKeys, signer.PubKey())
+	}
+
+	suite.multisig = wallet.NewMultisigAccount(kmultisig.NewLegacyAminoPubKey(2, pubKeys), c, suite.encodingCfg.TxConfig)
+}
+
+func (suite *MultisigTestSuite) TestMultisigTx() {
+	testCases := []struct {
+		name      string
+		signers   func() []*wallet.Wallet
+		shouldErr bool
+	}{
+		{
+			name: "not enough signatures returns error",
+			signers: func() []*wallet.Wallet {
+				return []*wallet.Wallet{suite.members[0]}
+			},
+			shouldErr: true,
+		},
+		{
+			name: "duplicated signatures of the same member return error",
+			signers: func() []*wallet.Wallet {
+				return []*wallet.Wallet{suite.members[0], suite.members[0]}
+			},
+			shouldErr: true,
+		},
+		{
+			name: "threshold signatures are combined properly",
+			signers: func() []*wallet.Wallet {
+				return []*wallet.Wallet{suite.members[2], suite.members[0]}
+			},
+		},
+		{
+			name: "all signatures are combined properly",
+			signers: func() []*wallet.Wallet {
+				return suite.members
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			data := types.NewTransactionData(
+				newTestMsgSend(suite.multisig.AccAddress()),
+			).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000)))).
+				WithChainID("morpheus-apollo-3").WithAccountNumber(10).WithSequence(3)
+
+			builder, err := suite.multisig.BuildUnsignedTx(context.Background(), data)
+			suite.Require().NoError(err)
+
+			signerData, err := suite.multisig.GetSignerData(context.Background(), data)
+			suite.Require().NoError(err)
+
+			var sigs []signing.SignatureV2
+			for _, signer := range tc.signers() {
+				sig, err := signer.SignMultisigTx(suite.multisig.PubKey(), builder, signerData)
+				suite.Require().NoError(err)
+				sigs = append(sigs, sig)
+			}
+
+			err = suite.multisig.CombineSignatures(builder, signerData, sigs...)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// Verify the multisig signature
+			txSigs, err := builder.GetTx().GetSignaturesV2()
+			suite.Require().NoError(err)
+			suite.Require().Len(txSigs, 1)
+
+			err = authsigning.VerifySignature(
+				suite.multisig.PubKey(),
+				authsigning.SignerData{
+					Address:       suite.multisig.AccAddress(),
+					ChainID:       signerData.ChainID,
+					AccountNumber: signerData.AccountNumber,
+					Sequence:      signerData.Sequence,
+					PubKey:        suite.multisig.PubKey(),
+				},
+				txSigs[0].Data,
+				suite.encodingCfg.TxConfig.SignModeHandler(),
+				builder.GetTx(),
+			)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *MultisigTestSuite) TestCombineInvalidSignatures() {
+	data := types.NewTransactionData(
+		newTestMsgSend(suite.multisig.AccAddress()),
+	).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000)))).
+		WithChainID("morpheus-apollo-3").WithAccountNumber(10).WithSequence(3)
+
+	builder, err := suite.multisig.BuildUnsignedTx(context.Background(), data)
+	suite.Require().NoError(err)
+
+	signerData, err := suite.multisig.GetSignerData(context.Background(), data)
+	suite.Require().NoError(err)
+
+	validSig, err := suite.members[0].SignMultisigTx(suite.multisig.PubKey(), builder, signerData)
+	suite.Require().NoError(err)
+
+	// Sign using a different chain id, so that the signature is not valid for the given signer data
+	wrongSignerData := signerData
+	wrongSignerData.ChainID = "desmos-mainnet"
+	invalidSig, err := suite.members[1].SignMultisigTx(suite.multisig.PubKey(), builder, wrongSignerData)
+	suite.Require().NoError(err)
+
+	err = suite.multisig.CombineSignatures(builder, signerData, validSig, invalidSig)
+	suite.Require().Error(err)
+}
+
+func (suite *MultisigTestSuite) TestSignMultisigTxNonMember() {
+	data := types.NewTransactionData(
+		newTestMsgSend(suite.multisig.AccAddress()),
+	).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000))))
+
+	builder, err := suite.multisig.BuildUnsignedTx(context.Background(), data)
+	suite.Require().NoError(err)
+
+	_, err = suite.outsider.SignMultisigTx(suite.multisig.PubKey(), builder, wallet.SignerData{ChainID: "morpheus-apollo-3"})
+	suite.Require().Error(err)
+}
+
+func (suite *MultisigTestSuite) TestFeeLimits() {
+	limits := &client.FeeLimits{
+		MaxGas: 300_000,
+		MaxFee: sdk.NewCoins(sdk.NewInt64Coin("udaric", 3_000)),
+	}
+
+	signer, err := wallet.NewMnemonicSigner(testMnemonic, "m/44'/852'/0'/0/0", hd.Secp256k1)
+	suite.Require().NoError(err)
+	limitedMember := wallet.NewOfflineWallet(signer, "desmos", suite.encodingCfg.TxConfig)
+	limitedMember.FeeLimits = limits
+
+	limitedMultisig := wallet.NewMultisigAccount(suite.multisig.PubKey(), suite.multisig.Client, suite.encodingCfg.TxConfig)
+	limitedMultisig.FeeLimits = limits
+
+	testCases := []struct {
+		name      string
+		multisig  *wallet.MultisigAccount
+		member    *wallet.Wallet
+		gasLimit  uint64
+		fee       int64
+		shouldErr bool
+	}{
+		{
+			name:     "transaction within the limits is built and signed",
+			multisig: limitedMultisig,
+			member:   limitedMember,
+			gasLimit: 200_000,
+			fee:      2_000,
+		},
+		{
+			name:      "transaction exceeding the multisig limits is not built",
+			multisig:  limitedMultisig,
+			member:    suite.members[0],
+			gasLimit:  400_000,
+			fee:       2_000,
+			shouldErr: true,
+		},
+		{
+			name:      "transaction exceeding the member limits is not signed",
+			multisig:  suite.multisig,
+			member:    limitedMember,
+			gasLimit:  200_000,
+			fee:       3_001,
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			data := types.NewTransactionData(
+				newTestMsgSend(tc.multisig.AccAddress()),
+			).WithGasLimit(tc.gasLimit).WithFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("udaric", tc.fee))).
+				WithChainID("morpheus-apollo-3").WithAccountNumber(10).WithSequence(3)
+
+			signerData, err := tc.multisig.GetSignerData(context.Background(), data)
+			suite.Require().NoError(err)
+
+			builder, err := tc.multisig.BuildUnsignedTx(context.Background(), data)
+			if err == nil {
+				_, err = tc.member.SignMultisigTx(tc.multisig.PubKey(), builder, signerData)
+			}
+
+			if tc.shouldErr {
+				suite.Require().ErrorIs(err, client.ErrFeeLimitExceeded)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
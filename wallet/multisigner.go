@@ -0,0 +1,198 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+)
+
+// BuildMultiSignerTx creates and signs a transaction that requires the signatures of multiple accounts
+// (e.g. a transaction containing messages sent by different accounts), using the given wallets.
+// A wallet must be given for each signer of the transaction, in any order. The signatures are set following
+// the order of the transaction signers, and the first signer pays for fees unless a fee payer is set.
+// The sequence of each signer is handed out by the local tracker of its wallet, so the account number and sequence
+// must not be set inside the data. The sequences are considered as used once the transaction has been built: if it
+// is not broadcast, the next transaction of each wallet is rejected with a sequence mismatch and the trackers are
+// synced again.
+// The client and tx config of the first wallet are used to build the transaction.
+func BuildMultiSignerTx(ctx context.Context, data *types.TransactionData, wallets ...*Wallet) (sdkclient.TxBuilder, error) {
+	if len(wallets) == 0 {
+		return nil, fmt.Errorf("at least one wallet must be provided")
+	}
+
+	if data.AccountNumber != nil || data.Sequence != nil {
+		return nil, fmt.Errorf("the account number and sequence cannot be set when building a multi-signer transaction")
+	}
+
+	c, txConfig := wallets[0].Client, wallets[0].TxConfig
+
+	signers, err := sortSigners(txConfig, data, wallets)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := data.ChainID
+	if chainID == "" {
+		if c == nil {
+			return nil, fmt.Errorf("the chain id must be set when building a transaction offline")
+		}
+
+		chainID, err = c.GetChainIDCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Get the sequence of each signer from its tracker, so that the ones of the transactions in flight are not used
+	sequences := make([]uint64, 0, len(signers))
+	for _, signer := range signers {
+		sequence, err := signer.sequence.Next(signer.fetchSequence(ctx))
+		if err != nil {
+			rollbackSequences(signers, sequences)
+			return nil, fmt.Errorf("error while getting sequence of %s: %w", signer.AccAddress(), err)
+		}
+		sequences = append(sequences, sequence)
+	}
+
+	builder, err := buildMultiSignerTx(ctx, data, chainID, signers, sequences)
+	if err != nil {
+		rollbackSequences(signers, sequences)
+		return nil, err
+	}
+
+	for i, signer := range signers {
+		signer.sequence.Used(sequences[i])
+	}
+
+	return builder, nil
+}
+
+// buildMultiSignerTx creates and signs a transaction using the given data, signed by the given wallets
+// using the given sequences. The wallets must be sorted following the order of the transaction signers.
+func buildMultiSignerTx(
+	ctx context.Context, data *types.TransactionData, chainID string, signers []*Wallet, sequences []uint64,
+) (sdkclient.TxBuilder, error) {
+	c, txConfig := signers[0].Client, signers[0].TxConfig
+
+	// Get the data of each signer
+	signersData := make([]SignerData, len(signers))
+	simulationSigs := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		// Copy the data so that the one provided by the caller is not changed
+		txData := *data
+		txData.Sequence = &sequences[i]
+
+		accountNumber, sequence, err := signer.getAccountData(ctx, &txData)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting account data of %s: %w", signer.AccAddress(), err)
+		}
+
+		signersData[i] = SignerData{
+			ChainID:       chainID,
+			AccountNumber: accountNumber,
+			Sequence:      sequence,
+		}
+		simulationSigs[i] = signer.simulationSignature(sequence, data.SignMode)
+	}
+
+	builder, _, err := buildUnsignedTx(ctx, c, txConfig, data, simulationSigs...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the limits of all the wallets, since each one of them signs the transaction
+	for _, signer := range signers {
+		err = signer.getFeeLimits().Check(builder.GetTx().GetGas(), builder.GetTx().GetFee())
+		if err != nil {
+			return nil, fmt.Errorf("error while checking the limits of %s: %w", signer.AccAddress(), err)
+		}
+	}
+
+	// Set all the signer infos first, since they are signed when using SIGN_MODE_DIRECT
+	signMode := getSignMode(data.SignMode)
+	sigs := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		sigs[i] = signing.SignatureV2{
+			PubKey:   signer.signer.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: signersData[i].Sequence,
+		}
+	}
+
+	err = builder.SetSignatures(sigs...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign the transaction with all the wallets
+	for i, signer := range signers {
+		sigs[i], err = signer.signTx(
+			signMode,
+			authsigning.SignerData{
+				Address:       signer.AccAddress(),
+				ChainID:       signersData[i].ChainID,
+				AccountNumber: signersData[i].AccountNumber,
+				Sequence:      signersData[i].Sequence,
+				PubKey:        signer.signer.PubKey(),
+			},
+			builder,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = builder.SetSignatures(sigs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return builder, nil
+}
+
+// rollbackSequences rolls back the given sequences handed out to the given wallets, so that they are used again
+func rollbackSequences(wallets []*Wallet, sequences []uint64) {
+	for i, sequence := range sequences {
+		wallets[i].sequence.Rollback(sequence)
+	}
+}
+
+// sortSigners returns the given wallets sorted following the order of the signers of the transaction
+// built using the given data, returning an error if a signer does not have a wallet or a wallet is not a signer
+func sortSigners(txConfig sdkclient.TxConfig, data *types.TransactionData, wallets []*Wallet) ([]*Wallet, error) {
+	builder := txConfig.NewTxBuilder()
+	err := builder.SetMsgs(data.Messages...)
+	if err != nil {
+		return nil, err
+	}
+	if data.FeePayer != nil {
+		builder.SetFeePayer(data.FeePayer)
+	}
+
+	signers := builder.GetTx().GetSigners()
+	if len(signers) != len(wallets) {
+		return nil, fmt.Errorf("invalid number of wallets: expected %d, got %d", len(signers), len(wallets))
+	}
+
+	sorted := make([]*Wallet, len(signers))
+	for i, signer := range signers {
+		for _, wallet := range wallets {
+			if bytes.Equal(wallet.signer.PubKey().Address(), signer) {
+				sorted[i] = wallet
+				break
+			}
+		}
+
+		if sorted[i] == nil {
+			return nil, fmt.Errorf("no wallet provided for signer %s", signer)
+		}
+	}
+
+	return sorted, nil
+}
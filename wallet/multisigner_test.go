@@ -0,0 +1,220 @@
+package wallet_test
+
+import (
+	"context"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/desmos-labs/cosmos-go-wallet/client"
+	"github.com/desmos-labs/cosmos-go-wallet/testutils"
+	"github.com/desmos-labs/cosmos-go-wallet/types"
+	"github.com/desmos-labs/cosmos-go-wallet/wallet"
+)
+
+func (suite *WalletTestSuite) TestBuildMultiSignerTx() {
+	c := suite.newTestClient("0.01udaric")
+
+	txClient := &testutils.MockTxClient{GasUsed: 100_000}
+	c.AuthClient = &testutils.MockAuthClient{AccountNumber: 10, Sequence: 3}
+	c.TxClient = txClient
+
+	// Use new keys so that their addresses have never been cached by sdk.AccAddress#String using a different prefix
+	first := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), c, suite.encodingCfg.TxConfig)
+	second := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), c, suite.encodingCfg.TxConfig)
+	other := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), c, suite.encodingCfg.TxConfig)
+
+	buildData := func(signMode signing.SignMode) *types.TransactionData {
+		return types.NewTransactionData(
+			newTestMsgSend(first.AccAddress()),
+			newTestMsgSend(second.AccAddress()),
+		).WithGasAuto().WithFeeAuto().WithSignMode(signMode)
+	}
+
+	testCases := []struct {
+		name        string
+		data        *types.TransactionData
+		wallets     []*wallet.Wallet
+		shouldErr   bool
+		expSequence uint64
+	}{
+		{
+			name:      "missing signer wallet returns error",
+			data:      buildData(signing.SignMode_SIGN_MODE_DIRECT),
+			wallets:   []*wallet.Wallet{first, other},
+			shouldErr: true,
+		},
+		{
+			name:      "wrong number of wallets returns error",
+			data:      buildData(signing.SignMode_SIGN_MODE_DIRECT),
+			wallets:   []*wallet.Wallet{first},
+			shouldErr: true,
+		},
+		{
+			name:      "sequence set inside the data returns error",
+			data:      buildData(signing.SignMode_SIGN_MODE_DIRECT).WithSequence(1),
+			wallets:   []*wallet.Wallet{first, second},
+			shouldErr: true,
+		},
+		{
+			name:        "direct sign mode",
+			data:        buildData(signing.SignMode_SIGN_MODE_DIRECT),
+			wallets:     []*wallet.Wallet{second, first},
+			expSequence: 3,
+		},
+		{
+			name:        "amino JSON sign mode uses the following sequences",
+			data:        buildData(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON),
+			wallets:     []*wallet.Wallet{first, second},
+			expSequence: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			builder, err := wallet.BuildMultiSignerTx(context.Background(), tc.data, tc.wallets...)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			tx := builder.GetTx()
+			suite.Require().Equal(uint64(150_000), tx.GetGas())
+
+			// Make sure the signatures are set in the signers order and are valid
+			sigs, err := tx.GetSignaturesV2()
+			suite.Require().NoError(err)
+			suite.Require().Len(sigs, 2)
+
+			for i, signer := range []*wallet.Wallet{first, second} {
+				suite.Require().Equal(signer.AccAddress(), sdk.MustBech32ifyAddressBytes("desmos", sigs[i].PubKey.Address()))
+				suite.Require().Equal(tc.expSequence, sigs[i].Sequence)
+
+				signerData := authsigning.SignerData{
+					Address:       signer.AccAddress(),
+					ChainID:       "morpheus-apollo-3",
+					AccountNumber: 10,
+					Sequence:      tc.expSequence,
+					PubKey:        sigs[i].PubKey,
+				}
+				err = authsigning.VerifySignature(sigs[i].PubKey, signerData, sigs[i].Data, suite.encodingCfg.TxConfig.SignModeHandler(), tx)
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *WalletTestSuite) TestBuildMultiSignerTxFeeLimits() {
+	c := suite.newTestClient("0.01udaric")
+	c.AuthClient = &testutils.MockAuthClient{AccountNumber: 10, Sequence: 3}
+	c.TxClient = &testutils.MockTxClient{GasUsed: 100_000}
+
+	first := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), c, suite.encodingCfg.TxConfig)
+	second := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), c, suite.encodingCfg.TxConfig)
+
+	testCases := []struct {
+		name      string
+		limited   *wallet.Wallet
+		feeLimits client.FeeLimits
+		shouldErr bool
+	}{
+		{
+			name:      "transaction within the limits is signed",
+			limited:   second,
+			feeLimits: client.FeeLimits{MaxGas: 200_000},
+		},
+		{
+			name:      "transaction exceeding the limits of the fee payer is not signed",
+			limited:   first,
+			feeLimits: client.FeeLimits{MaxFee: sdk.NewCoins(sdk.NewInt64Coin("udaric", 1_000))},
+			shouldErr: true,
+		},
+		{
+			name:      "transaction exceeding the limits of another signer is not signed",
+			limited:   second,
+			feeLimits: client.FeeLimits{MaxGas: 100_000},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			tc.limited.FeeLimits = &tc.feeLimits
+			defer func() { tc.limited.FeeLimits = nil }()
+
+			data := types.NewTransactionData(
+				newTestMsgSend(first.AccAddress()),
+				newTestMsgSend(second.AccAddress()),
+			).WithGasAuto().WithFeeAuto()
+
+			builder, err := wallet.BuildMultiSignerTx(context.Background(), data, first, second)
+			if tc.shouldErr {
+				suite.Require().ErrorIs(err, client.ErrFeeLimitExceeded)
+				suite.Require().Nil(builder)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *WalletTestSuite) TestBuildMultiSignerTxSequences() {
+	c := suite.newTestClient("0.01udaric")
+
+	authClient := &testutils.MockAuthClient{AccountNumber: 10, Sequence: 3}
+	c.AuthClient = authClient
+	c.TxClient = &testutils.MockTxClient{GasUsed: 100_000}
+	c.RPCClient = &testutils.MockRPCClient{
+		Results: []*coretypes.ResultBroadcastTx{{Hash: []byte{0x01}}},
+		Errors:  []error{nil},
+	}
+
+	first := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), c, suite.encodingCfg.TxConfig)
+	second := wallet.NewWalletFromSigner(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), c, suite.encodingCfg.TxConfig)
+
+	buildTx := func() (sdkclient.TxBuilder, error) {
+		return wallet.BuildMultiSignerTx(context.Background(), types.NewTransactionData(
+			newTestMsgSend(first.AccAddress()),
+			newTestMsgSend(second.AccAddress()),
+		).WithGasAuto().WithFeeAuto(), first, second)
+	}
+
+	requireSequences := func(builder sdkclient.TxBuilder, expected ...uint64) {
+		sigs, err := builder.GetTx().GetSignaturesV2()
+		suite.Require().NoError(err)
+		suite.Require().Len(sigs, len(expected))
+		for i, sig := range sigs {
+			suite.Require().Equal(expected[i], sig.Sequence)
+		}
+	}
+
+	// The sequences are read from the chain only the first time
+	builder, err := buildTx()
+	suite.Require().NoError(err)
+	requireSequences(builder, 3, 3)
+	suite.Require().Equal(2, authClient.Calls)
+
+	// The transactions broadcast by a wallet use the following sequences
+	_, err = first.BroadcastTxCtx(context.Background(), types.NewTransactionData(
+		newTestMsgSend(first.AccAddress()),
+	).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("udaric", 2_000))), client.BroadcastSync)
+	suite.Require().NoError(err)
+
+	// A transaction that cannot be built rolls back the sequences
+	first.FeeLimits = &client.FeeLimits{MaxGas: 100_000}
+	_, err = buildTx()
+	suite.Require().ErrorIs(err, client.ErrFeeLimitExceeded)
+	first.FeeLimits = nil
+
+	builder, err = buildTx()
+	suite.Require().NoError(err)
+	requireSequences(builder, 5, 4)
+	suite.Require().Equal(2, authClient.Calls)
+}
@@ -0,0 +1,198 @@
+package wallet
+
+import (
+	"sort"
+	"sync"
+)
+
+// sequenceTracker keeps track of the sequence of an account locally, so that multiple
+// transactions can be built and broadcast concurrently without waiting for each one of them
+// to be included inside a block.
+// The tracker remembers which sequences have been handed out and are still in flight, so that a sequence
+// is never handed out twice while the transaction using it might still reach the mempool.
+type sequenceTracker struct {
+	mu          sync.Mutex
+	initialized bool
+
+	// next is the sequence following the highest one handed out
+	next uint64
+
+	// inFlight contains the sequences that have been handed out and whose broadcast result is not known yet
+	inFlight map[uint64]bool
+
+	// unused contains the sequences lower than next that are known not to be used, sorted in ascending order
+	unused []uint64
+}
+
+// Next returns the next sequence that should be used, and marks it as in flight.
+// Sequences known not to be used are handed out first.
+// If the tracker has not been synced yet, the given fetch function is used to read the current
+// sequence from the chain.
+func (t *sequenceTracker) Next(fetch func() (uint64, error)) (uint64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	err := t.sync(fetch)
+	if err != nil {
+		return 0, err
+	}
+
+	var sequence uint64
+	if len(t.unused) > 0 {
+		sequence, t.unused = t.unused[0], t.unused[1:]
+	} else {
+		sequence = t.next
+		t.next++
+	}
+
+	if t.inFlight == nil {
+		t.inFlight = map[uint64]bool{}
+	}
+	t.inFlight[sequence] = true
+	return sequence, nil
+}
+
+// Peek returns the next sequence that should be used without marking it as used.
+// If the tracker has not been synced yet, the given fetch function is used to read the current
+// sequence from the chain.
+func (t *sequenceTracker) Peek(fetch func() (uint64, error)) (uint64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	err := t.sync(fetch)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(t.unused) > 0 {
+		return t.unused[0], nil
+	}
+	return t.next, nil
+}
+
+// sync reads the current sequence using the given fetch function if the tracker has not been synced yet.
+// The caller must hold the lock.
+func (t *sequenceTracker) sync(fetch func() (uint64, error)) error {
+	if t.initialized {
+		return nil
+	}
+
+	sequence, err := fetch()
+	if err != nil {
+		return err
+	}
+
+	t.next = sequence
+	t.unused = nil
+	t.initialized = true
+	return nil
+}
+
+// Used marks the given sequence as used by a transaction that has reached the mempool, or that might have
+// reached it. If it has not, the next transaction is rejected with a sequence mismatch and the tracker is
+// synced again using Mismatch.
+func (t *sequenceTracker) Used(sequence uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.inFlight, sequence)
+}
+
+// Rollback marks the given sequence as unused, so that it is handed out again
+func (t *sequenceTracker) Rollback(sequence uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.inFlight, sequence)
+	if !t.initialized || sequence >= t.next {
+		return
+	}
+
+	t.addUnused(sequence)
+	t.trimUnused()
+}
+
+// Mismatch syncs the tracker after the transaction using the given sequence has been rejected because the
+// chain expected the given one instead.
+// All the sequences from the expected one onwards that are not in flight anymore are handed out again, while
+// the ones still in flight are never handed out twice.
+func (t *sequenceTracker) Mismatch(sequence uint64, expected uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.inFlight, sequence)
+
+	if !t.initialized || expected >= t.next {
+		t.next = expected
+		t.unused = nil
+		t.initialized = true
+		return
+	}
+
+	t.unused = nil
+	for s := expected; s < t.next; s++ {
+		if !t.inFlight[s] {
+			t.unused = append(t.unused, s)
+		}
+	}
+	t.trimUnused()
+}
+
+// Set sets the next sequence that should be handed out, forgetting about the ones in flight
+func (t *sequenceTracker) Set(sequence uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.next = sequence
+	t.unused = nil
+	t.inFlight = nil
+	t.initialized = true
+}
+
+// Reset resets the tracker so that the next sequence is read again from the chain
+func (t *sequenceTracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.initialized = false
+	t.unused = nil
+	t.inFlight = nil
+}
+
+// ResetIfIdle resets the tracker if no sequence is in flight. Otherwise the tracker is kept, since the chain
+// does not know yet about the transactions in flight and reading the sequence from it would hand out
+// sequences that are already in use.
+func (t *sequenceTracker) ResetIfIdle() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.inFlight) > 0 {
+		return
+	}
+
+	t.initialized = false
+	t.unused = nil
+}
+
+// addUnused adds the given sequence to the unused ones, keeping them sorted.
+// The caller must hold the lock.
+func (t *sequenceTracker) addUnused(sequence uint64) {
+	i := sort.Search(len(t.unused), func(i int) bool { return t.unused[i] >= sequence })
+	if i < len(t.unused) && t.unused[i] == sequence {
+		return
+	}
+
+	t.unused = append(t.unused, 0)
+	copy(t.unused[i+1:], t.unused[i:])
+	t.unused[i] = sequence
+}
+
+// trimUnused moves next back while the highest sequence handed out is unused, so that unused sequences
+// are always lower than the highest one in flight.
+// The caller must hold the lock.
+func (t *sequenceTracker) trimUnused() {
+	for len(t.unused) > 0 && t.unused[len(t.unused)-1] == t.next-1 {
+		t.unused = t.unused[:len(t.unused)-1]
+		t.next--
+	}
+}
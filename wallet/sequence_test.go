@@ -0,0 +1,291 @@
+package wallet
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSequenceTrackerTestSuite(t *testing.T) {
+	suite.Run(t, new(SequenceTrackerTestSuite))
+}
+
+type SequenceTrackerTestSuite struct {
+	suite.Suite
+}
+
+func (suite *SequenceTrackerTestSuite) TestNext() {
+	var tracker sequenceTracker
+
+	fetches := 0
+	fetch := func() (uint64, error) {
+		fetches++
+		return 10, nil
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	sequences := map[uint64]bool{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sequence, err := tracker.Next(fetch)
+			suite.Require().NoError(err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			sequences[sequence] = true
+		}()
+	}
+	wg.Wait()
+
+	suite.Require().Equal(1, fetches)
+	suite.Require().Len(sequences, 50)
+	for i := uint64(10); i < 60; i++ {
+		suite.Require().True(sequences[i], "sequence %d has not been handed out", i)
+	}
+}
+
+func (suite *SequenceTrackerTestSuite) TestNextFetchError() {
+	var tracker sequenceTracker
+
+	_, err := tracker.Next(func() (uint64, error) {
+		return 0, fmt.Errorf("node unavailable")
+	})
+	suite.Require().Error(err)
+
+	sequence, err := tracker.Next(func() (uint64, error) {
+		return 3, nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), sequence)
+}
+
+func (suite *SequenceTrackerTestSuite) TestRollback() {
+	testCases := []struct {
+		name     string
+		rollback func(tracker *sequenceTracker)
+		expected uint64
+	}{
+		{
+			name: "rolling back the last sequence reuses it",
+			rollback: func(tracker *sequenceTracker) {
+				tracker.Rollback(6)
+			},
+			expected: 6,
+		},
+		{
+			name: "rolling back an older sequence reuses it",
+			rollback: func(tracker *sequenceTracker) {
+				tracker.Rollback(5)
+			},
+			expected: 5,
+		},
+		{
+			name: "resetting while sequences are in flight keeps the tracker",
+			rollback: func(tracker *sequenceTracker) {
+				tracker.Used(5)
+				tracker.ResetIfIdle()
+			},
+			expected: 7,
+		},
+		{
+			name: "resetting while idle syncs again",
+			rollback: func(tracker *sequenceTracker) {
+				tracker.Used(5)
+				tracker.Used(6)
+				tracker.ResetIfIdle()
+			},
+			expected: 0,
+		},
+		{
+			name: "reset syncs again",
+			rollback: func(tracker *sequenceTracker) {
+				tracker.Reset()
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			var tracker sequenceTracker
+			tracker.Set(5)
+
+			_, err := tracker.Next(nil)
+			suite.Require().NoError(err)
+			_, err = tracker.Next(nil)
+			suite.Require().NoError(err)
+
+			tc.rollback(&tracker)
+
+			sequence, err := tracker.Next(func() (uint64, error) {
+				return 0, nil
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, sequence)
+		})
+	}
+}
+
+func (suite *SequenceTrackerTestSuite) TestMismatch() {
+	testCases := []struct {
+		name     string
+		mismatch func(tracker *sequenceTracker)
+		expected []uint64
+	}{
+		{
+			name: "higher expected sequence moves forward",
+			mismatch: func(tracker *sequenceTracker) {
+				tracker.Mismatch(12, 20)
+			},
+			expected: []uint64{20, 21},
+		},
+		{
+			name: "lower expected sequence hands out again only the sequences not in flight",
+			mismatch: func(tracker *sequenceTracker) {
+				tracker.Rollback(12)
+				tracker.Mismatch(15, 12)
+			},
+			expected: []uint64{12, 15, 20, 21},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			var tracker sequenceTracker
+			tracker.Set(10)
+
+			// Hand out the sequences from 10 to 19 concurrently
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := tracker.Next(nil)
+					suite.Require().NoError(err)
+				}()
+			}
+			wg.Wait()
+
+			tracker.Used(10)
+			tracker.Used(11)
+			tc.mismatch(&tracker)
+
+			// Hand out new sequences concurrently, making sure none of the ones in flight is used again
+			var mu sync.Mutex
+			sequences := map[uint64]bool{}
+			for range tc.expected {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					sequence, err := tracker.Next(nil)
+					suite.Require().NoError(err)
+
+					mu.Lock()
+					defer mu.Unlock()
+					suite.Require().False(sequences[sequence], "sequence %d handed out twice", sequence)
+					sequences[sequence] = true
+				}()
+			}
+			wg.Wait()
+
+			for _, sequence := range tc.expected {
+				suite.Require().True(sequences[sequence], "sequence %d has not been handed out", sequence)
+			}
+		})
+	}
+}
+
+func (suite *SequenceTrackerTestSuite) TestUpdateSequence() {
+	testCases := []struct {
+		name     string
+		res      *sdk.TxResponse
+		expected uint64
+	}{
+		{
+			name:     "successful transaction uses the sequence",
+			res:      &sdk.TxResponse{Code: 0},
+			expected: 6,
+		},
+		{
+			name: "transaction already inside the mempool cache uses the sequence",
+			res: &sdk.TxResponse{
+				Codespace: sdkerrors.ErrTxInMempoolCache.Codespace(),
+				Code:      sdkerrors.ErrTxInMempoolCache.ABCICode(),
+			},
+			expected: 6,
+		},
+		{
+			name: "transaction rejected during CheckTx does not use the sequence",
+			res: &sdk.TxResponse{
+				Codespace: sdkerrors.ErrInsufficientFee.Codespace(),
+				Code:      sdkerrors.ErrInsufficientFee.ABCICode(),
+			},
+			expected: 5,
+		},
+		{
+			name: "sequence mismatch sets the expected sequence",
+			res: &sdk.TxResponse{
+				Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+				Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+				RawLog:    "account sequence mismatch, expected 8, got 5: incorrect account sequence",
+			},
+			expected: 8,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			w := &Wallet{}
+			w.sequence.Set(5)
+
+			sequence, err := w.sequence.Next(nil)
+			suite.Require().NoError(err)
+
+			w.updateSequence(sequence, tc.res)
+
+			next, err := w.sequence.Next(nil)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, next)
+		})
+	}
+}
+
+func (suite *SequenceTrackerTestSuite) TestParseExpectedSequence() {
+	testCases := []struct {
+		name        string
+		rawLog      string
+		shouldParse bool
+		expected    uint64
+	}{
+		{
+			name:        "sequence mismatch log is parsed properly",
+			rawLog:      "account sequence mismatch, expected 25, got 23: incorrect account sequence",
+			shouldParse: true,
+			expected:    25,
+		},
+		{
+			name:        "different log is not parsed",
+			rawLog:      "insufficient fees; got: 10udaric required: 20udaric: insufficient fee",
+			shouldParse: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			sequence, ok := parseExpectedSequence(tc.rawLog)
+			suite.Require().Equal(tc.shouldParse, ok)
+			suite.Require().Equal(tc.expected, sequence)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Signer represents an entity that is able to sign transactions on behalf of an account
+type Signer interface {
+	// PubKey returns the public key of the account
+	PubKey() cryptotypes.PubKey
+
+	// Sign signs the given bytes that have been generated using the provided sign mode
+	Sign(bz []byte, signMode signing.SignMode) ([]byte, error)
+}
+
+var (
+	_ Signer = &PrivKeySigner{}
+	_ Signer = &KeyringSigner{}
+)
+
+// PrivKeySigner represents a Signer that holds the private key of the account in memory
+type PrivKeySigner struct {
+	privKey cryptotypes.PrivKey
+}
+
+// NewPrivKeySigner returns a new PrivKeySigner instance signing with the given private key
+func NewPrivKeySigner(privKey cryptotypes.PrivKey) *PrivKeySigner {
+	return &PrivKeySigner{
+		privKey: privKey,
+	}
+}
+
+// NewMnemonicSigner returns a new PrivKeySigner instance signing with the private key derived from the given
+// mnemonic using the provided HD path and algorithm
+func NewMnemonicSigner(mnemonic string, hdPath string, algo keyring.SignatureAlgo) (*PrivKeySigner, error) {
+	derivedPriv, err := algo.Derive()(mnemonic, "", hdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPrivKeySigner(algo.Generate()(derivedPriv)), nil
+}
+
+// NewHexPrivKeySigner returns a new PrivKeySigner instance signing with the given hex-encoded private key
+// of the provided algorithm
+func NewHexPrivKeySigner(hexPrivKey string, algo keyring.SignatureAlgo) (*PrivKeySigner, error) {
+	bz, err := hex.DecodeString(hexPrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("error while decoding private key: %s", err)
+	}
+
+	// All the supported algorithms use 32 bytes private keys (or seeds in the case of ed25519)
+	if len(bz) != secp256k1.PrivKeySize {
+		return nil, fmt.Errorf("invalid private key length: expected %d, got %d", secp256k1.PrivKeySize, len(bz))
+	}
+
+	return NewPrivKeySigner(algo.Generate()(bz)), nil
+}
+
+// NewArmoredPrivKeySigner returns a new PrivKeySigner instance signing with the private key contained
+// inside the given ASCII-armored string, which is decrypted using the provided passphrase
+func NewArmoredPrivKeySigner(armor string, passphrase string) (*PrivKeySigner, error) {
+	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("error while decrypting private key: %s", err)
+	}
+
+	return NewPrivKeySigner(privKey), nil
+}
+
+// PubKey implements Signer
+func (s *PrivKeySigner) PubKey() cryptotypes.PubKey {
+	return s.privKey.PubKey()
+}
+
+// Sign implements Signer
+func (s *PrivKeySigner) Sign(bz []byte, _ signing.SignMode) ([]byte, error) {
+	return s.privKey.Sign(bz)
+}
+
+// KeyringSigner represents a Signer that signs using a key stored inside a Cosmos SDK keyring
+type KeyringSigner struct {
+	keyring keyring.Keyring
+	record  *keyring.Record
+	pubKey  cryptotypes.PubKey
+}
+
+// NewKeyringSigner returns a new KeyringSigner instance signing with the key having the given name
+// inside the provided keyring
+func NewKeyringSigner(kr keyring.Keyring, keyName string) (*KeyringSigner, error) {
+	record, err := kr.Key(keyName)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting key %s from keyring: %s", keyName, err)
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting public key of key %s: %s", keyName, err)
+	}
+
+	return &KeyringSigner{
+		keyring: kr,
+		record:  record,
+		pubKey:  pubKey,
+	}, nil
+}
+
+// PubKey implements Signer
+func (s *KeyringSigner) PubKey() cryptotypes.PubKey {
+	return s.pubKey
+}
+
+// Sign implements Signer
+func (s *KeyringSigner) Sign(bz []byte, signMode signing.SignMode) ([]byte, error) {
+	if s.record.GetType() == keyring.TypeLedger && signMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return nil, fmt.Errorf("ledger keys only support %s sign mode", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
+	sig, _, err := s.keyring.Sign(s.record.Name, bz)
+	return sig, err
+}
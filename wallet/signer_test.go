@@ -0,0 +1,123 @@
+package wallet_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/desmos-labs/cosmos-go-wallet/testutils"
+	"github.com/desmos-labs/cosmos-go-wallet/wallet"
+)
+
+const (
+	testMnemonic  = "forward service profit benefit punch catch fan chief jealous steel harvest column spell rude warm home melody hat broccoli pulse say garlic you firm"
+	testHDPath    = "m/44'/852'/0'/0/0"
+	testRecipient = "desmos1q62k9kvjy7v2wh0yt9jqaepnzezz3s49j9gnpk"
+)
+
+func TestSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(SignerTestSuite))
+}
+
+type SignerTestSuite struct {
+	suite.Suite
+
+	mnemonicSigner *wallet.PrivKeySigner
+}
+
+func (suite *SignerTestSuite) SetupSuite() {
+	signer, err := wallet.NewMnemonicSigner(testMnemonic, testHDPath, hd.Secp256k1)
+	suite.Require().NoError(err)
+	suite.mnemonicSigner = signer
+}
+
+func (suite *SignerTestSuite) TestSigners() {
+	testCases := []struct {
+		name      string
+		buildFn   func() (wallet.Signer, error)
+		shouldErr bool
+	}{
+		{
+			name: "mnemonic signer",
+			buildFn: func() (wallet.Signer, error) {
+				return wallet.NewMnemonicSigner(testMnemonic, testHDPath, hd.Secp256k1)
+			},
+		},
+		{
+			name: "invalid hex private key returns error",
+			buildFn: func() (wallet.Signer, error) {
+				return wallet.NewHexPrivKeySigner("abcd", hd.Secp256k1)
+			},
+			shouldErr: true,
+		},
+		{
+			name: "hex private key signer",
+			buildFn: func() (wallet.Signer, error) {
+				derived, err := hd.Secp256k1.Derive()(testMnemonic, "", testHDPath)
+				suite.Require().NoError(err)
+				return wallet.NewHexPrivKeySigner(hex.EncodeToString(derived), hd.Secp256k1)
+			},
+		},
+		{
+			name: "armored private key signer",
+			buildFn: func() (wallet.Signer, error) {
+				derived, err := hd.Secp256k1.Derive()(testMnemonic, "", testHDPath)
+				suite.Require().NoError(err)
+
+				armor := crypto.EncryptArmorPrivKey(hd.Secp256k1.Generate()(derived), "passphrase", "secp256k1")
+				return wallet.NewArmoredPrivKeySigner(armor, "passphrase")
+			},
+		},
+		{
+			name: "armored private key with wrong passphrase returns error",
+			buildFn: func() (wallet.Signer, error) {
+				derived, err := hd.Secp256k1.Derive()(testMnemonic, "", testHDPath)
+				suite.Require().NoError(err)
+
+				armor := crypto.EncryptArmorPrivKey(hd.Secp256k1.Generate()(derived), "passphrase", "secp256k1")
+				return wallet.NewArmoredPrivKeySigner(armor, "wrong")
+			},
+			shouldErr: true,
+		},
+		{
+			name: "keyring signer",
+			buildFn: func() (wallet.Signer, error) {
+				kr := keyring.NewInMemory(testutils.MakeTestEncodingConfig().Codec)
+				_, err := kr.NewAccount("key", testMnemonic, "", testHDPath, hd.Secp256k1)
+				suite.Require().NoError(err)
+				return wallet.NewKeyringSigner(kr, "key")
+			},
+		},
+		{
+			name: "keyring signer with missing key returns error",
+			buildFn: func() (wallet.Signer, error) {
+				kr := keyring.NewInMemory(testutils.MakeTestEncodingConfig().Codec)
+				return wallet.NewKeyringSigner(kr, "key")
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			signer, err := tc.buildFn()
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(suite.mnemonicSigner.PubKey().Equals(signer.PubKey()))
+
+			msg := []byte("message to be signed")
+			sig, err := signer.Sign(msg, signing.SignMode_SIGN_MODE_DIRECT)
+			suite.Require().NoError(err)
+			suite.Require().True(signer.PubKey().VerifySignature(msg, sig))
+		})
+	}
+}
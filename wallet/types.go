@@ -0,0 +1,35 @@
+package wallet
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BroadcastResult contains the result of broadcasting a transaction
+type BroadcastResult struct {
+	// TxResponse is the response of the last broadcast attempt
+	TxResponse *sdk.TxResponse
+
+	// Attempts contains the responses of all the broadcast attempts, in order
+	Attempts []*sdk.TxResponse
+
+	// TxBytes, GasLimit and Fee are the encoded bytes, the gas limit and the fee amount of the last built transaction
+	TxBytes  []byte
+	GasLimit uint64
+	Fee      sdk.Coins
+
+	// TimeoutHeight is the timeout height of the last built transaction, or zero if it has none
+	TimeoutHeight uint64
+
+	// DryRun tells whether the transaction has only been built without being broadcast
+	DryRun bool
+
+	// GasEstimate is the adjusted amount of gas estimated by simulating the transaction during a dry run
+	GasEstimate uint64
+}
+
+// SignerData contains the data required to sign a transaction
+type SignerData struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+}
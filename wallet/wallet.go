@@ -1,50 +1,101 @@
 package wallet
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
 
+	tmtypes "github.com/cometbft/cometbft/types"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/tx"
-	"github.com/cosmos/cosmos-sdk/crypto/hd"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 
 	"github.com/desmos-labs/cosmos-go-wallet/client"
+	"github.com/desmos-labs/cosmos-go-wallet/crypto/hd"
 	"github.com/desmos-labs/cosmos-go-wallet/types"
 )
 
+var (
+	expectedSequenceRegex = regexp.MustCompile(`expected (\d+), got \d+`)
+
+	errOfflineAccountData = fmt.Errorf("the account number and sequence must be set when building a transaction offline")
+)
+
 // Wallet represents a Cosmos wallet that should be used to create and send transactions to the chain
 type Wallet struct {
-	privKey cryptotypes.PrivKey
+	signer   Signer
+	prefix   string
+	sequence sequenceTracker
+
+	// accountNumber is the account number read from the chain, which is cached since it never changes
+	accountNumberMu sync.RWMutex
+	accountNumber   *uint64
 
 	TxConfig sdkclient.TxConfig
 	Client   *client.Client
+
+	// FeeLimits, if set, is used instead of the client limits to check the gas and fees of the transactions
+	// before signing them. It allows to limit the transactions built by offline wallets as well.
+	FeeLimits *client.FeeLimits
 }
 
-// NewWallet allows to build a new Wallet instance
+// NewWallet allows to build a new Wallet instance.
+// If a keyring backend is set inside the config, the wallet signs using the keyring key instead of the mnemonic.
+// The passphrase of a file keyring is read from the environment variable set inside the config, and the standard
+// input is never used: NewKeyringWallet should be used to provide the passphrase in a different way.
 func NewWallet(accountCfg *types.AccountConfig, client *client.Client, txConfig sdkclient.TxConfig) (*Wallet, error) {
-	// Get the private types
-	algo := hd.Secp256k1
-	derivedPriv, err := algo.Derive()(accountCfg.Mnemonic, "", accountCfg.HDPath)
+	if accountCfg.KeyringBackend != "" {
+		passphrase, err := getKeyringPassphrase(accountCfg)
+		if err != nil {
+			return nil, err
+		}
+		return NewKeyringWallet(accountCfg, passphrase, client, txConfig)
+	}
+
+	algo, err := hd.GetAlgorithm(accountCfg.KeyAlgorithm)
 	if err != nil {
 		return nil, err
 	}
 
+	signer, err := NewMnemonicSigner(accountCfg.Mnemonic, accountCfg.HDPath, algo)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWalletFromSigner(signer, client, txConfig), nil
+}
+
+// NewWalletFromSigner allows to build a new Wallet instance that signs transactions using the given signer
+func NewWalletFromSigner(signer Signer, client *client.Client, txConfig sdkclient.TxConfig) *Wallet {
 	return &Wallet{
-		privKey:  algo.Generate()(derivedPriv),
+		signer:   signer,
+		prefix:   client.GetAccountPrefix(),
 		TxConfig: txConfig,
 		Client:   client,
-	}, nil
+	}
+}
+
+// NewOfflineWallet allows to build a new Wallet instance that signs transactions using the given signer without
+// being connected to any chain. Such a wallet can only build transactions having all the signer data, gas and fees
+// set explicitly.
+func NewOfflineWallet(signer Signer, bech32Prefix string, txConfig sdkclient.TxConfig) *Wallet {
+	return &Wallet{
+		signer:   signer,
+		prefix:   bech32Prefix,
+		TxConfig: txConfig,
+	}
 }
 
 // AccAddress returns the address of the account that is going to be used to sign the transactions
 func (w *Wallet) AccAddress() string {
-	bech32Addr, err := bech32.ConvertAndEncode(w.Client.GetAccountPrefix(), w.privKey.PubKey().Address())
+	bech32Addr, err := bech32.ConvertAndEncode(w.prefix, w.signer.PubKey().Address())
 	if err != nil {
 		panic(err)
 	}
@@ -54,50 +105,595 @@ func (w *Wallet) AccAddress() string {
 // BroadcastTxAsync creates and signs a transaction with the provided messages and fees,
 // then broadcasts it using the async method
 func (w *Wallet) BroadcastTxAsync(data *types.TransactionData) (*sdk.TxResponse, error) {
-	builder, err := w.BuildTx(data)
-	if err != nil {
+	return w.BroadcastTxAsyncCtx(context.Background(), data)
+}
+
+// BroadcastTxAsyncCtx creates and signs a transaction with the provided messages and fees,
+// then broadcasts it using the async method and the given context
+func (w *Wallet) BroadcastTxAsyncCtx(ctx context.Context, data *types.TransactionData) (*sdk.TxResponse, error) {
+	res, err := w.BroadcastTxCtx(ctx, data, client.BroadcastAsync)
+	if res == nil {
 		return nil, err
 	}
 
-	return w.Client.BroadcastTxAsync(builder.GetTx())
+	return res.TxResponse, err
 }
 
 // BroadcastTxSync creates and signs a transaction with the provided messages and fees,
 // then broadcasts it using the sync method
 func (w *Wallet) BroadcastTxSync(data *types.TransactionData) (*sdk.TxResponse, error) {
-	builder, err := w.BuildTx(data)
-	if err != nil {
+	return w.BroadcastTxSyncCtx(context.Background(), data)
+}
+
+// BroadcastTxSyncCtx creates and signs a transaction with the provided messages and fees,
+// then broadcasts it using the sync method and the given context
+func (w *Wallet) BroadcastTxSyncCtx(ctx context.Context, data *types.TransactionData) (*sdk.TxResponse, error) {
+	res, err := w.BroadcastTxCtx(ctx, data, client.BroadcastSync)
+	if res == nil {
 		return nil, err
 	}
 
-	return w.Client.BroadcastTxSync(builder.GetTx())
+	return res.TxResponse, err
 }
 
 // BroadcastTxCommit creates and signs a transaction with the provided messages and fees,
 // then broadcasts it using the commit method
 func (w *Wallet) BroadcastTxCommit(data *types.TransactionData) (*sdk.TxResponse, error) {
-	builder, err := w.BuildTx(data)
+	return w.BroadcastTxCommitCtx(context.Background(), data)
+}
+
+// BroadcastTxCommitCtx creates and signs a transaction with the provided messages and fees,
+// then broadcasts it using the commit method and the given context
+func (w *Wallet) BroadcastTxCommitCtx(ctx context.Context, data *types.TransactionData) (*sdk.TxResponse, error) {
+	res, err := w.BroadcastTxCtx(ctx, data, client.BroadcastCommit)
+	if res == nil {
+		return nil, err
+	}
+
+	return res.TxResponse, err
+}
+
+// BroadcastTxAndWait creates and signs a transaction with the provided messages and fees, broadcasts it using
+// the sync method and then waits until it is included inside a block.
+// If the transaction is rejected by the node, the response is returned along with an error wrapping both
+// client.ErrTxRejected and the error returned by client.ErrorFromTxResponse.
+// If the context is done before the transaction is included, an error wrapping client.ErrTxNotFound is returned,
+// while if the chain goes past the transaction timeout height, an error wrapping client.ErrTxTimeoutHeight is returned.
+func (w *Wallet) BroadcastTxAndWait(ctx context.Context, data *types.TransactionData) (*sdk.TxResponse, error) {
+	res, err := w.BroadcastTxCtx(ctx, data, client.BroadcastSync)
+	if res == nil {
+		return nil, err
+	}
+
+	// Dry run transactions are never included inside a block
+	if res.DryRun {
+		return res.TxResponse, nil
+	}
+
+	if res.TxResponse.Code != 0 {
+		return res.TxResponse, fmt.Errorf("%w: %w", client.ErrTxRejected, client.ErrorFromTxResponse(res.TxResponse))
+	}
+
+	return w.Client.WaitForTxUntilHeight(ctx, res.TxResponse.TxHash, res.TimeoutHeight)
+}
+
+// BroadcastTxCtx creates and signs a transaction with the provided messages and fees,
+// then broadcasts it using the given mode and context.
+// If the transaction is rejected due to an account sequence mismatch, it is built and broadcast again
+// using the sequence expected by the chain, up to the number of retries set inside the data.
+// If client.Client.ReturnTxErrors is set and the transaction fails, the result is returned along with a *client.TxError.
+func (w *Wallet) BroadcastTxCtx(ctx context.Context, data *types.TransactionData, mode client.BroadcastMode) (*BroadcastResult, error) {
+	// Copy the data so that the one provided by the caller is not changed
+	txData := *data
+
+	result := &BroadcastResult{DryRun: data.DryRun}
+	for {
+		res, err := w.broadcastTx(ctx, &txData, mode, result)
+		if res == nil {
+			return nil, err
+		}
+
+		result.TxResponse = res
+		result.Attempts = append(result.Attempts, res)
+
+		if !isWrongSequence(res) || uint(len(result.Attempts)) > data.SequenceRetries {
+			return result, err
+		}
+
+		expected, ok := parseExpectedSequence(res.RawLog)
+		if !ok {
+			return result, err
+		}
+
+		// When no sequence is set, the local tracker has already been updated with the expected one
+		if data.Sequence != nil {
+			txData.Sequence = &expected
+		}
+	}
+}
+
+// ResetSequence resets the locally tracked account sequence, so that it is read again from the chain
+// when broadcasting the next transaction
+func (w *Wallet) ResetSequence() {
+	w.sequence.Reset()
+}
+
+// broadcastTx builds and signs a transaction using the given data, and then broadcasts it using the given mode.
+// If no sequence is specified inside the data, the sequence is handed out by the local sequence tracker so that
+// multiple transactions can be broadcast concurrently.
+// The details of the built transaction are set inside the given result.
+func (w *Wallet) broadcastTx(ctx context.Context, data *types.TransactionData, mode client.BroadcastMode, result *BroadcastResult) (*sdk.TxResponse, error) {
+	if data.DryRun {
+		return w.dryRunTx(ctx, data, result)
+	}
+
+	if w.Client == nil {
+		return nil, fmt.Errorf("transactions cannot be broadcast by an offline wallet")
+	}
+
+	if data.Sequence != nil {
+		_, txBytes, _, err := w.buildTx(ctx, data, result)
+		if err != nil {
+			return nil, err
+		}
+		return w.Client.BroadcastTxBytes(ctx, txBytes, mode)
+	}
+
+	sequence, err := w.sequence.Next(w.fetchSequence(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the data so that the one provided by the caller is not changed
+	txData := *data
+	txData.Sequence = &sequence
+
+	_, txBytes, _, err := w.buildTx(ctx, &txData, result)
+	if err != nil {
+		w.sequence.Rollback(sequence)
+		return nil, err
+	}
+
+	res, err := w.Client.BroadcastTxBytes(ctx, txBytes, mode)
+	if res == nil {
+		// We cannot know whether the transaction reached the mempool, so the sequence is considered as used.
+		// If it has not been used, the next transaction is rejected with the expected sequence and the
+		// tracker is synced again.
+		w.sequence.Used(sequence)
+		return nil, err
+	}
+
+	// The error, if any, is a *client.TxError that should be returned along with the response
+	w.updateSequence(sequence, res)
+	return res, err
+}
+
+// dryRunTx builds, simulates and signs a transaction using the given data without broadcasting it, and returns
+// a synthetic response containing the transaction hash computed locally.
+// If the gas has already been computed by simulating the transaction, the result of such simulation is used.
+// The local sequence tracker is not updated, so the same sequence is used by the next transaction.
+func (w *Wallet) dryRunTx(ctx context.Context, data *types.TransactionData, result *BroadcastResult) (*sdk.TxResponse, error) {
+	// Copy the data so that the one provided by the caller is not changed
+	txData := *data
+	if txData.Sequence == nil {
+		sequence, err := w.sequence.Peek(w.fetchSequence(ctx))
+		if err != nil {
+			return nil, err
+		}
+		txData.Sequence = &sequence
+	}
+
+	tx, txBytes, simRes, err := w.buildTx(ctx, &txData, result)
 	if err != nil {
 		return nil, err
 	}
 
-	return w.Client.BroadcastTxCommit(builder.GetTx())
+	res := &sdk.TxResponse{
+		TxHash:    fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()),
+		GasWanted: int64(result.GasLimit),
+	}
+
+	if simRes == nil && w.Client != nil {
+		simRes, err = w.Client.SimulateCtx(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if simRes != nil {
+		res.GasUsed = int64(simRes.GasUsed)
+		result.GasEstimate = simRes.AdjustedGas
+	}
+
+	return res, nil
+}
+
+// buildTx builds and signs a transaction using the given data, and returns it along with its encoded bytes and
+// the result of the simulation performed to compute the gas, if any.
+// The details of the built transaction are set inside the given result.
+func (w *Wallet) buildTx(
+	ctx context.Context, data *types.TransactionData, result *BroadcastResult,
+) (authsigning.Tx, []byte, *client.SimulationResult, error) {
+	builder, simRes, err := w.buildSignedTx(ctx, data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tx := builder.GetTx()
+	txBytes, err := w.EncodeTx(tx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	result.TxBytes = txBytes
+	result.GasLimit = tx.GetGas()
+	result.Fee = tx.GetFee()
+	result.TimeoutHeight = tx.GetTimeoutHeight()
+
+	return tx, txBytes, simRes, nil
+}
+
+// fetchSequence returns a function reading the current sequence of the account from the chain,
+// which caches the account number as well
+func (w *Wallet) fetchSequence(ctx context.Context) func() (uint64, error) {
+	return func() (uint64, error) {
+		if w.Client == nil {
+			return 0, errOfflineAccountData
+		}
+
+		account, err := w.Client.GetAccountCtx(ctx, w.AccAddress())
+		if err != nil {
+			return 0, fmt.Errorf("error while getting the account from the chain: %w", err)
+		}
+		w.setAccountNumber(account.GetAccountNumber())
+		return account.GetSequence(), nil
+	}
+}
+
+// updateSequence updates the local sequence tracker based on the result of the
+// broadcast of a transaction that used the given sequence
+func (w *Wallet) updateSequence(sequence uint64, res *sdk.TxResponse) {
+	switch {
+	case res.Code == 0, isTxInMempoolCache(res):
+		// The transaction is inside the mempool, so the sequence has been used
+		w.sequence.Used(sequence)
+
+	case isWrongSequence(res):
+		expected, ok := parseExpectedSequence(res.RawLog)
+		if ok {
+			w.sequence.Mismatch(sequence, expected)
+		} else {
+			// Read the sequence from the chain again only if it knows about all the other transactions
+			w.sequence.Rollback(sequence)
+			w.sequence.ResetIfIdle()
+		}
+
+	case res.Height == 0:
+		// The transaction has been rejected during CheckTx, so the sequence has not been used
+		w.sequence.Rollback(sequence)
+
+	default:
+		// The transaction has been included inside a block and failed, so the sequence has been used
+		w.sequence.Used(sequence)
+	}
+}
+
+// isWrongSequence tells whether the given response represents an account sequence mismatch error
+func isWrongSequence(res *sdk.TxResponse) bool {
+	return res.Codespace == sdkerrors.ErrWrongSequence.Codespace() && res.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+// isTxInMempoolCache tells whether the given response has been returned because the transaction is already
+// inside the mempool cache
+func isTxInMempoolCache(res *sdk.TxResponse) bool {
+	return res.Codespace == sdkerrors.ErrTxInMempoolCache.Codespace() && res.Code == sdkerrors.ErrTxInMempoolCache.ABCICode()
+}
+
+// parseExpectedSequence parses the sequence expected by the chain from the raw log of an
+// account sequence mismatch error (e.g. "account sequence mismatch, expected 10, got 9")
+func parseExpectedSequence(rawLog string) (uint64, bool) {
+	matches := expectedSequenceRegex.FindStringSubmatch(rawLog)
+	if len(matches) != 2 {
+		return 0, false
+	}
+
+	sequence, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return sequence, true
 }
 
+// BuildTx creates and signs a transaction with the provided messages and fees
 func (w *Wallet) BuildTx(data *types.TransactionData) (sdkclient.TxBuilder, error) {
+	return w.BuildTxCtx(context.Background(), data)
+}
+
+// BuildTxCtx creates and signs a transaction with the provided messages and fees, using the given
+// context for all the requests made to the chain.
+// If the sequence, gas and fees are all set inside the data, no request is made to the chain once the account
+// number and the chain id have been read and cached.
+func (w *Wallet) BuildTxCtx(ctx context.Context, data *types.TransactionData) (sdkclient.TxBuilder, error) {
+	builder, _, err := w.buildSignedTx(ctx, data)
+	return builder, err
+}
+
+// buildSignedTx creates and signs a transaction with the provided messages and fees, and returns it along with
+// the result of the simulation performed to compute the gas, if any
+func (w *Wallet) buildSignedTx(ctx context.Context, data *types.TransactionData) (sdkclient.TxBuilder, *client.SimulationResult, error) {
+	accountNumber, sequence, err := w.getAccountData(ctx, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chainID := data.ChainID
+	if chainID == "" {
+		if w.Client == nil {
+			return nil, nil, fmt.Errorf("the chain id must be set when building a transaction offline")
+		}
+
+		chainID, err = w.Client.GetChainIDCtx(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	data = withAuxFeePayer(data, w.signer.PubKey().Address().Bytes())
+	builder, simRes, err := buildUnsignedTx(ctx, w.Client, w.TxConfig, data, w.simulationSignature(sequence, data.SignMode))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = w.getFeeLimits().Check(builder.GetTx().GetGas(), builder.GetTx().GetFee())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = w.SignTx(builder, SignerData{
+		ChainID:       chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+	}, data.SignMode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return builder, simRes, nil
+}
+
+// BuildUnsignedTx creates a transaction with the provided messages and fees without signing it.
+// The chain is queried only when the gas or the fees should be computed automatically.
+func (w *Wallet) BuildUnsignedTx(ctx context.Context, data *types.TransactionData) (sdkclient.TxBuilder, error) {
+	var sequence uint64
+	if data.GasAuto {
+		// The sequence is required to properly simulate the transaction
+		_, accSequence, err := w.getAccountData(ctx, data)
+		if err != nil {
+			return nil, err
+		}
+		sequence = accSequence
+	}
+
+	data = withAuxFeePayer(data, w.signer.PubKey().Address().Bytes())
+	builder, _, err := buildUnsignedTx(ctx, w.Client, w.TxConfig, data, w.simulationSignature(sequence, data.SignMode))
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.getFeeLimits().Check(builder.GetTx().GetGas(), builder.GetTx().GetFee())
+	if err != nil {
+		return nil, err
+	}
+
+	return builder, nil
+}
+
+// SignTx signs the transaction contained inside the given builder using the provided signer data and sign mode.
+// If the sign mode is not specified, SIGN_MODE_DIRECT is used.
+// The signatures of the other signers already set inside the builder are kept, and the wallet signature is placed
+// at the index of the wallet inside the transaction signers.
+// This method does not perform any request to the chain, so it can be used to sign transactions offline.
+func (w *Wallet) SignTx(builder sdkclient.TxBuilder, signerData SignerData, signMode signing.SignMode) error {
+	signMode = getSignMode(signMode)
+
+	// Set an empty signature first
+	sigData := signing.SingleSignatureData{
+		SignMode: signMode,
+	}
+	sig := signing.SignatureV2{
+		PubKey:   w.signer.PubKey(),
+		Data:     &sigData,
+		Sequence: signerData.Sequence,
+	}
+
+	sigs, err := w.withSignature(builder, sig)
+	if err != nil {
+		return err
+	}
+
+	err = builder.SetSignatures(sigs...)
+	if err != nil {
+		return err
+	}
+
+	// Sign the transaction
+	sig, err = w.signTx(
+		signMode,
+		authsigning.SignerData{
+			Address:       w.AccAddress(),
+			ChainID:       signerData.ChainID,
+			AccountNumber: signerData.AccountNumber,
+			Sequence:      signerData.Sequence,
+			PubKey:        w.signer.PubKey(),
+		},
+		builder,
+	)
+	if err != nil {
+		return err
+	}
+
+	sigs, err = w.withSignature(builder, sig)
+	if err != nil {
+		return err
+	}
+
+	return builder.SetSignatures(sigs...)
+}
+
+// withSignature returns the signatures set inside the given builder, replacing the one of this wallet with the
+// given signature. The signatures of the other signers (e.g. the auxiliary signers) are kept, and the given one is
+// placed at the index of the wallet inside the transaction signers, since each signature must match the signer
+// having the same index.
+func (w *Wallet) withSignature(builder sdkclient.TxBuilder, sig signing.SignatureV2) ([]signing.SignatureV2, error) {
+	index := -1
+	for i, signer := range builder.GetTx().GetSigners() {
+		if bytes.Equal(signer, w.signer.PubKey().Address()) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("the wallet address %s is not a signer of the transaction", w.AccAddress())
+	}
+
+	otherSigs, err := w.otherSignatures(builder)
+	if err != nil {
+		return nil, err
+	}
+
+	if index > len(otherSigs) {
+		return nil, fmt.Errorf("the signatures of the signers preceding %s must be set first", w.AccAddress())
+	}
+
+	sigs := make([]signing.SignatureV2, 0, len(otherSigs)+1)
+	sigs = append(sigs, otherSigs[:index]...)
+	sigs = append(sigs, sig)
+	return append(sigs, otherSigs[index:]...), nil
+}
+
+// otherSignatures returns the signatures set inside the given builder that have not been made by this wallet
+func (w *Wallet) otherSignatures(builder sdkclient.TxBuilder) ([]signing.SignatureV2, error) {
+	sigs, err := builder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	var otherSigs []signing.SignatureV2
+	for _, sig := range sigs {
+		if sig.PubKey == nil || !sig.PubKey.Equals(w.signer.PubKey()) {
+			otherSigs = append(otherSigs, sig)
+		}
+	}
+	return otherSigs, nil
+}
+
+// EncodeTx returns the bytes of the given transaction, that can be broadcast using Client.BroadcastTxBytes
+func (w *Wallet) EncodeTx(tx sdk.Tx) ([]byte, error) {
+	return w.TxConfig.TxEncoder()(tx)
+}
+
+// EncodeTxJSON returns the JSON representation of the given transaction
+func (w *Wallet) EncodeTxJSON(tx sdk.Tx) ([]byte, error) {
+	return w.TxConfig.TxJSONEncoder()(tx)
+}
+
+// DecodeTxJSON decodes the given JSON representation of a transaction, and returns a builder containing it
+func (w *Wallet) DecodeTxJSON(bz []byte) (sdkclient.TxBuilder, error) {
+	tx, err := w.TxConfig.TxJSONDecoder()(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.TxConfig.WrapTxBuilder(tx)
+}
+
+// getAccountData returns the account number and sequence that should be used to sign a transaction built with
+// the given data. The account number is read from the chain only the first time, and then cached.
+func (w *Wallet) getAccountData(ctx context.Context, data *types.TransactionData) (accountNumber uint64, sequence uint64, err error) {
+	cachedAccountNumber := w.getAccountNumber()
+	if data.AccountNumber == nil && cachedAccountNumber != nil {
+		// Copy the data so that the one provided by the caller is not changed
+		txData := *data
+		txData.AccountNumber = cachedAccountNumber
+		data = &txData
+	}
+
+	accountNumber, sequence, err = getAccountData(ctx, w.Client, w.AccAddress(), data)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if data.AccountNumber == nil {
+		w.setAccountNumber(accountNumber)
+	}
+
+	return accountNumber, sequence, nil
+}
+
+// getFeeLimits returns the limits that the gas and fees of the transactions must respect
+func (w *Wallet) getFeeLimits() client.FeeLimits {
+	if w.FeeLimits != nil {
+		return *w.FeeLimits
+	}
+	if w.Client != nil {
+		return w.Client.FeeLimits
+	}
+	return client.FeeLimits{}
+}
+
+// getAccountNumber returns the cached account number, or nil if it has not been read from the chain yet
+func (w *Wallet) getAccountNumber() *uint64 {
+	w.accountNumberMu.RLock()
+	defer w.accountNumberMu.RUnlock()
+	return w.accountNumber
+}
+
+// setAccountNumber caches the given account number read from the chain
+func (w *Wallet) setAccountNumber(accountNumber uint64) {
+	w.accountNumberMu.Lock()
+	defer w.accountNumberMu.Unlock()
+	w.accountNumber = &accountNumber
+}
+
+// getAccountData returns the account number and sequence of the account having the given address that should be
+// used to sign a transaction built with the given data.
+// If they are not both set inside the data, the missing ones are read from the chain.
+func getAccountData(ctx context.Context, c *client.Client, address string, data *types.TransactionData) (accountNumber uint64, sequence uint64, err error) {
+	if data.AccountNumber != nil && data.Sequence != nil {
+		return *data.AccountNumber, *data.Sequence, nil
+	}
+
+	if c == nil {
+		return 0, 0, errOfflineAccountData
+	}
+
 	// Get the account
-	account, err := w.Client.GetAccount(w.AccAddress())
+	account, err := c.GetAccountCtx(ctx, address)
 	if err != nil {
-		return nil, fmt.Errorf("error while getting the account from the chain: %s", err)
+		return 0, 0, fmt.Errorf("error while getting the account from the chain: %w", err)
 	}
 
-	// Set account sequence
+	accountNumber, sequence = account.GetAccountNumber(), account.GetSequence()
+	if data.AccountNumber != nil {
+		accountNumber = *data.AccountNumber
+	}
 	if data.Sequence != nil {
-		account.SetSequence(*data.Sequence)
+		sequence = *data.Sequence
 	}
 
+	return accountNumber, sequence, nil
+}
+
+// buildUnsignedTx creates a transaction with the provided messages and fees, and returns it along with the
+// result of the simulation performed to compute the gas, if any.
+// The given signatures, one for each signer in order, are set only when simulating the transaction
+// to compute the gas automatically.
+func buildUnsignedTx(
+	ctx context.Context, c *client.Client, txConfig sdkclient.TxConfig, data *types.TransactionData, simulationSigs ...signing.SignatureV2,
+) (sdkclient.TxBuilder, *client.SimulationResult, error) {
 	// Build the transaction
-	builder := w.TxConfig.NewTxBuilder()
+	builder := txConfig.NewTxBuilder()
 	if data.Memo != "" {
 		builder.SetMemo(data.Memo)
 	}
@@ -105,103 +701,211 @@ func (w *Wallet) BuildTx(data *types.TransactionData) (sdkclient.TxBuilder, erro
 		builder.SetFeeGranter(data.FeeGranter)
 	}
 
-	if len(data.Messages) == 0 {
-		return nil, fmt.Errorf("error while building a transaction with no messages")
+	if len(data.Messages) == 0 && len(data.AuxSignerData) == 0 {
+		return nil, nil, fmt.Errorf("error while building a transaction with no messages")
+	}
+
+	if !data.Tip.Empty() {
+		return nil, nil, fmt.Errorf("tips can only be set when building auxiliary signer data")
 	}
 
-	err = builder.SetMsgs(data.Messages...)
+	err := builder.SetMsgs(data.Messages...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	err = setExtensionOptions(builder, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if (data.GasAuto || data.FeeAuto) && c == nil {
+		return nil, nil, fmt.Errorf("gas and fees must be set when building a transaction offline")
+	}
+
+	if len(data.AuxSignerData) > 0 {
+		// The messages, memo, timeout height and tip are the ones signed by the auxiliary signers
+		err = addAuxSignerData(builder, data)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		timeoutHeight, err := getTimeoutHeight(ctx, c, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		builder.SetTimeoutHeight(timeoutHeight)
 	}
 
+	// The fee payer must be set after the auxiliary signer data, since it changes the signers of the transaction
+	if data.FeePayer != nil {
+		builder.SetFeePayer(data.FeePayer)
+	}
+
+	var simRes *client.SimulationResult
 	gasLimit := data.GasLimit
 	if data.GasAuto {
-		adjusted, err := w.simulateTx(account, builder)
+		simRes, err = simulateTx(ctx, c, simulationSigs, builder)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		gasLimit = adjusted
+		gasLimit = simRes.AdjustedGas
 	}
 
 	feeAmount := data.FeeAmount
 	if data.FeeAuto {
 		// Compute the fee amount based on the gas limit and the gas price
-		feeAmount = w.Client.GetFees(int64(gasLimit))
+		feeAmount, err = getFees(ctx, c, builder, data, gasLimit)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// Set the new gas and fee
 	builder.SetGasLimit(gasLimit)
 	builder.SetFeeAmount(feeAmount)
 
-	// Set an empty signature first
-	sigData := signing.SingleSignatureData{
-		SignMode: signing.SignMode_SIGN_MODE_DIRECT,
+	return builder, simRes, nil
+}
+
+// setExtensionOptions sets the extension options of the given data inside the given builder,
+// returning an error if the builder does not support them
+func setExtensionOptions(builder sdkclient.TxBuilder, data *types.TransactionData) error {
+	if len(data.ExtensionOptions) == 0 && len(data.NonCriticalExtensionOptions) == 0 {
+		return nil
 	}
-	sig := signing.SignatureV2{
-		PubKey:   w.privKey.PubKey(),
-		Data:     &sigData,
-		Sequence: account.GetSequence(),
+
+	extBuilder, ok := builder.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return fmt.Errorf("extension options are not supported by the tx builder %T", builder)
+	}
+
+	extBuilder.SetExtensionOptions(data.ExtensionOptions...)
+	extBuilder.SetNonCriticalExtensionOptions(data.NonCriticalExtensionOptions...)
+	return nil
+}
+
+// getTimeoutHeight returns the timeout height that should be set inside the transaction built using the given data
+func getTimeoutHeight(ctx context.Context, c *client.Client, data *types.TransactionData) (uint64, error) {
+	if data.TimeoutHeight > 0 || data.TimeoutBlocks == 0 {
+		return data.TimeoutHeight, nil
+	}
+
+	if c == nil {
+		return 0, fmt.Errorf("the timeout height must be set when building a transaction offline")
 	}
 
-	err = builder.SetSignatures(sig)
+	latestHeight, err := c.GetLatestHeightCtx(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	chainID, err := w.Client.GetChainID()
+	return uint64(latestHeight) + data.TimeoutBlocks, nil
+}
+
+// getFees returns the fees that should be paid to perform the given transaction with the given gas limit.
+// If no fee denom is set and multiple gas prices are configured, the first denom the fee payer has enough balance of is used.
+func getFees(ctx context.Context, c *client.Client, builder sdkclient.TxBuilder, data *types.TransactionData, gasLimit uint64) (sdk.Coins, error) {
+	if data.FeeDenom != "" {
+		return c.GetFeesInDenomCtx(ctx, int64(gasLimit), data.FeeDenom)
+	}
+
+	if len(c.GetFeeDenoms()) < 2 {
+		return c.GetFeesCtx(ctx, int64(gasLimit))
+	}
+
+	payer := data.FeeGranter
+	if payer.Empty() {
+		payer = builder.GetTx().FeePayer()
+	}
+
+	payerAddr, err := bech32.ConvertAndEncode(c.GetAccountPrefix(), payer)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error while converting fee payer address: %s", err)
 	}
 
-	// Sign the transaction with the private key
-	sig, err = tx.SignWithPrivKey(
-		signing.SignMode_SIGN_MODE_DIRECT,
-		authsigning.SignerData{
-			ChainID:       chainID,
-			AccountNumber: account.GetAccountNumber(),
-			Sequence:      account.GetSequence(),
-		},
-		builder,
-		w.privKey,
-		w.TxConfig,
-		account.GetSequence(),
-	)
+	return c.GetAffordableFeesCtx(ctx, payerAddr, int64(gasLimit))
+}
+
+// signTx signs the transaction contained inside the given builder using the provided sign mode and signer data,
+// and returns the resulting signature
+func (w *Wallet) signTx(signMode signing.SignMode, signerData authsigning.SignerData, builder sdkclient.TxBuilder) (signing.SignatureV2, error) {
+	// Generate the bytes to be signed
+	signBytes, err := w.TxConfig.SignModeHandler().GetSignBytes(signMode, signerData, builder.GetTx())
 	if err != nil {
-		return nil, err
+		return signing.SignatureV2{}, err
 	}
 
-	err = builder.SetSignatures(sig)
+	signature, err := w.signer.Sign(signBytes, signMode)
 	if err != nil {
-		return nil, err
+		return signing.SignatureV2{}, fmt.Errorf("error while signing tx: %s", err)
 	}
 
-	return builder, nil
+	return signing.SignatureV2{
+		PubKey: w.signer.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: signature,
+		},
+		Sequence: signerData.Sequence,
+	}, nil
 }
 
-// simulateTx simulates the given transaction and returns the amount of adjusted gas that should be used
-func (w *Wallet) simulateTx(account authtypes.AccountI, builder sdkclient.TxBuilder) (uint64, error) {
-	// Create an empty signature literal as the ante handler will populate with a
-	// sentinel pubkey.
-	sig := signing.SignatureV2{
-		PubKey: &secp256k1.PubKey{},
+// simulationSignature returns the signature that should be set when simulating a transaction that will be signed
+// using the given sequence and sign mode.
+// An empty signature is used along with the signer public key, so that the ante handler can properly compute
+// the gas based on its type.
+func (w *Wallet) simulationSignature(sequence uint64, signMode signing.SignMode) signing.SignatureV2 {
+	return signing.SignatureV2{
+		PubKey: w.signer.PubKey(),
 		Data: &signing.SingleSignatureData{
-			SignMode: signing.SignMode_SIGN_MODE_DIRECT,
+			SignMode: getSignMode(signMode),
 		},
-		Sequence: account.GetSequence(),
+		Sequence: sequence,
 	}
-	err := builder.SetSignatures(sig)
+}
+
+// simulateTx simulates the given transaction after adding the given signatures to the existing ones,
+// and returns the result of the simulation
+func simulateTx(ctx context.Context, c *client.Client, sigs []signing.SignatureV2, builder sdkclient.TxBuilder) (*client.SimulationResult, error) {
+	// Keep the signatures already set (e.g. the ones of the auxiliary signers), and restore them after the simulation
+	prevSigs, err := builder.GetTx().GetSignaturesV2()
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+
+	err = builder.SetSignatures(append(prevSigs, sigs...)...)
+	if err != nil {
+		return nil, err
 	}
 
 	// Set a fake amount of gas and fees
 	builder.SetGasLimit(200_000)
-	builder.SetFeeAmount(w.Client.GetFees(int64(200_000)))
+	fees, err := c.GetFeesCtx(ctx, int64(200_000))
+	if err != nil {
+		return nil, err
+	}
+	builder.SetFeeAmount(fees)
 
 	// Simulate the execution of the transaction
-	adjusted, err := w.Client.SimulateTx(builder.GetTx())
+	simRes, err := c.SimulateCtx(ctx, builder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("error while simulating tx: %w", err)
+	}
+
+	// Remove the signatures set for the simulation
+	err = builder.SetSignatures(prevSigs...)
 	if err != nil {
-		return 0, fmt.Errorf("error while simulating tx: %s", err)
+		return nil, err
+	}
+
+	return simRes, nil
+}
+
+// getSignMode returns the given sign mode, or SIGN_MODE_DIRECT if it is not specified
+func getSignMode(signMode signing.SignMode) signing.SignMode {
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		return signing.SignMode_SIGN_MODE_DIRECT
 	}
-	return adjusted, nil
+	return signMode
 }
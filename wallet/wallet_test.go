@@ -1,15 +1,27 @@
 package wallet_test
 
 import (
+	"context"
 	_ "embed"
+	"encoding/hex"
 	"fmt"
 	"testing"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config" // import for side-effects
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/desmos-labs/cosmos-go-wallet/client"
 	"github.com/desmos-labs/cosmos-go-wallet/testutils"
@@ -24,8 +36,9 @@ func TestWalletTestSuite(t *testing.T) {
 type WalletTestSuite struct {
 	suite.Suite
 
-	wallet *wallet.Wallet
-	client *client.Client
+	wallet      *wallet.Wallet
+	client      *client.Client
+	encodingCfg testutils.EncodingConfig
 }
 
 func (suite *WalletTestSuite) SetupSuite() {
@@ -46,6 +59,7 @@ func (suite *WalletTestSuite) SetupSuite() {
 	cfg.SetBech32PrefixForAccount(chainCfg.Bech32Prefix, fmt.Sprintf("%spub", chainCfg.Bech32Prefix))
 
 	encodingCfg := testutils.MakeTestEncodingConfig()
+	suite.encodingCfg = encodingCfg
 
 	c, err := client.NewClient(&chainCfg, encodingCfg.Codec)
 	suite.Require().NoError(err)
@@ -56,10 +70,102 @@ func (suite *WalletTestSuite) SetupSuite() {
 	suite.wallet = w
 }
 
+// newTestClient returns a client for a local node using the given gas price, whose queries should be served by mocks
+func (suite *WalletTestSuite) newTestClient(gasPrice string) *client.Client {
+	c, err := client.NewClient(&types.ChainConfig{
+		ChainID:       "morpheus-apollo-3",
+		Bech32Prefix:  "desmos",
+		RPCAddr:       "http://localhost:26657",
+		GRPCAddr:      "http://localhost:9090",
+		GasPrice:      gasPrice,
+		GasAdjustment: 1.5,
+	}, suite.encodingCfg.Codec)
+	suite.Require().NoError(err)
+	return c
+}
+
+// newTestWallet returns a wallet signing with the test mnemonic using the given client.
+// If the client is nil, an offline wallet is returned instead.
+func (suite *WalletTestSuite) newTestWallet(c *client.Client) *wallet.Wallet {
+	signer, err := wallet.NewMnemonicSigner(testMnemonic, testHDPath, hd.Secp256k1)
+	suite.Require().NoError(err)
+
+	if c == nil {
+		return wallet.NewOfflineWallet(signer, "desmos", suite.encodingCfg.TxConfig)
+	}
+	return wallet.NewWalletFromSigner(signer, c, suite.encodingCfg.TxConfig)
+}
+
+// newTestMsgSend returns a MsgSend sending some tokens from the given address to testRecipient.
+// The Bech32 addresses are used directly, since sdk.AccAddress#String caches the addresses encoded by other tests
+// using different prefixes.
+func newTestMsgSend(from string) *banktypes.MsgSend {
+	return &banktypes.MsgSend{
+		FromAddress: from,
+		ToAddress:   testRecipient,
+		Amount:      sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(10000))),
+	}
+}
+
+func (suite *WalletTestSuite) TestAccAddress() {
+	testCases := []struct {
+		name       string
+		accountCfg types.AccountConfig
+		shouldErr  bool
+		expAddress string
+	}{
+		{
+			name: "unsupported algorithm returns error",
+			accountCfg: types.AccountConfig{
+				Mnemonic:     "test test test test test test test test test test test junk",
+				HDPath:       "m/44'/60'/0'/0/0",
+				KeyAlgorithm: "sr25519",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "eth_secp256k1 address is computed properly",
+			accountCfg: types.AccountConfig{
+				Mnemonic:     "test test test test test test test test test test test junk",
+				HDPath:       "m/44'/60'/0'/0/0",
+				KeyAlgorithm: "eth_secp256k1",
+			},
+			// Well-known development account 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266
+			expAddress: "f39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+		},
+		{
+			name: "ed25519 with non hardened path returns error",
+			accountCfg: types.AccountConfig{
+				Mnemonic:     "test test test test test test test test test test test junk",
+				HDPath:       "m/44'/118'/0'/0/0",
+				KeyAlgorithm: "ed25519",
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			w, err := wallet.NewWallet(&tc.accountCfg, suite.client, suite.encodingCfg.TxConfig)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			address, err := suite.client.ParseAddress(w.AccAddress())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAddress, hex.EncodeToString(address))
+		})
+	}
+}
+
 func (suite *WalletTestSuite) TestBuildTx() {
 	testCases := []struct {
 		name      string
 		msgs      []sdk.Msg
+		signMode  signing.SignMode
 		shouldErr bool
 		check     func(builder sdkclient.TxBuilder)
 	}{
@@ -70,11 +176,7 @@ func (suite *WalletTestSuite) TestBuildTx() {
 		{
 			name: "valid messages returns no error",
 			msgs: []sdk.Msg{
-				banktypes.NewMsgSend(
-					sdk.MustAccAddressFromBech32(suite.wallet.AccAddress()),
-					sdk.MustAccAddressFromBech32("desmos1q62k9kvjy7v2wh0yt9jqaepnzezz3s49j9gnpk"),
-					sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(10000))),
-				),
+				newTestMsgSend(suite.wallet.AccAddress()),
 			},
 			check: func(builder sdkclient.TxBuilder) {
 				tx := builder.GetTx()
@@ -82,6 +184,22 @@ func (suite *WalletTestSuite) TestBuildTx() {
 				suite.Require().NotEmptyf(tx.GetFee(), "Fees should not be empty")
 			},
 		},
+		{
+			name: "valid messages with amino JSON sign mode returns no error",
+			msgs: []sdk.Msg{
+				newTestMsgSend(suite.wallet.AccAddress()),
+			},
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			check: func(builder sdkclient.TxBuilder) {
+				sigs, err := builder.GetTx().GetSignaturesV2()
+				suite.Require().NoError(err)
+				suite.Require().Len(sigs, 1)
+
+				sigData, ok := sigs[0].Data.(*signing.SingleSignatureData)
+				suite.Require().True(ok)
+				suite.Require().Equal(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, sigData.SignMode)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -89,7 +207,7 @@ func (suite *WalletTestSuite) TestBuildTx() {
 		suite.Run(tc.name, func() {
 			data := types.NewTransactionData(
 				tc.msgs...,
-			).WithGasAuto().WithFeeAuto().WithMemo("Custom memo").WithSequence(0)
+			).WithGasAuto().WithFeeAuto().WithMemo("Custom memo").WithSequence(0).WithSignMode(tc.signMode)
 
 			builder, err := suite.wallet.BuildTx(data)
 			if tc.shouldErr {
@@ -104,3 +222,772 @@ func (suite *WalletTestSuite) TestBuildTx() {
 		})
 	}
 }
+
+func (suite *WalletTestSuite) TestBuildTxAminoJSON() {
+	signer, err := wallet.NewMnemonicSigner(testMnemonic, testHDPath, hd.Secp256k1)
+	suite.Require().NoError(err)
+
+	offlineWallet := wallet.NewOfflineWallet(signer, "desmos", suite.encodingCfg.TxConfig)
+
+	data := types.NewTransactionData(
+		newTestMsgSend(offlineWallet.AccAddress()),
+	).
+		WithGasLimit(200_000).
+		WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000)))).
+		WithMemo("Custom memo").
+		WithAccountNumber(10).
+		WithSequence(5).
+		WithChainID("morpheus-apollo-3").
+		WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+
+	builder, err := offlineWallet.BuildTxCtx(context.Background(), data)
+	suite.Require().NoError(err)
+
+	// Decode the encoded transaction, as done by the chain
+	txBytes, err := offlineWallet.EncodeTx(builder.GetTx())
+	suite.Require().NoError(err)
+
+	tx, err := suite.encodingCfg.TxConfig.TxDecoder()(txBytes)
+	suite.Require().NoError(err)
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	suite.Require().True(ok)
+
+	sigs, err := sigTx.GetSignaturesV2()
+	suite.Require().NoError(err)
+	suite.Require().Len(sigs, 1)
+	suite.Require().Equal(uint64(5), sigs[0].Sequence)
+
+	sigData, ok := sigs[0].Data.(*signing.SingleSignatureData)
+	suite.Require().True(ok)
+	suite.Require().Equal(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, sigData.SignMode)
+
+	// Verify the signature against the amino JSON sign bytes
+	err = authsigning.VerifySignature(
+		signer.PubKey(),
+		authsigning.SignerData{
+			Address:       offlineWallet.AccAddress(),
+			ChainID:       "morpheus-apollo-3",
+			AccountNumber: 10,
+			Sequence:      5,
+			PubKey:        signer.PubKey(),
+		},
+		sigData,
+		suite.encodingCfg.TxConfig.SignModeHandler(),
+		tx,
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *WalletTestSuite) TestOfflineSigning() {
+	signer, err := wallet.NewMnemonicSigner(testMnemonic, testHDPath, hd.Secp256k1)
+	suite.Require().NoError(err)
+
+	offlineWallet := wallet.NewOfflineWallet(signer, "desmos", suite.encodingCfg.TxConfig)
+
+	testCases := []struct {
+		name      string
+		data      *types.TransactionData
+		shouldErr bool
+	}{
+		{
+			name: "missing gas returns error",
+			data: types.NewTransactionData(
+				newTestMsgSend(offlineWallet.AccAddress()),
+			).WithGasAuto().WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000)))),
+			shouldErr: true,
+		},
+		{
+			name: "direct sign mode",
+			data: types.NewTransactionData(
+				newTestMsgSend(offlineWallet.AccAddress()),
+			).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000)))),
+		},
+		{
+			name: "amino JSON sign mode",
+			data: types.NewTransactionData(
+				newTestMsgSend(offlineWallet.AccAddress()),
+			).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000)))).
+				WithMemo("Custom memo").WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			// Generate the unsigned transaction
+			builder, err := offlineWallet.BuildUnsignedTx(context.Background(), tc.data)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			txJSON, err := offlineWallet.EncodeTxJSON(builder.GetTx())
+			suite.Require().NoError(err)
+
+			// Sign the transaction
+			builder, err = offlineWallet.DecodeTxJSON(txJSON)
+			suite.Require().NoError(err)
+
+			signerData := wallet.SignerData{ChainID: "morpheus-apollo-3", AccountNumber: 10, Sequence: 5}
+			err = offlineWallet.SignTx(builder, signerData, tc.data.SignMode)
+			suite.Require().NoError(err)
+
+			txBytes, err := offlineWallet.EncodeTx(builder.GetTx())
+			suite.Require().NoError(err)
+
+			// Verify the signature
+			tx, err := suite.encodingCfg.TxConfig.TxDecoder()(txBytes)
+			suite.Require().NoError(err)
+
+			sigTx, ok := tx.(authsigning.SigVerifiableTx)
+			suite.Require().True(ok)
+
+			sigs, err := sigTx.GetSignaturesV2()
+			suite.Require().NoError(err)
+			suite.Require().Len(sigs, 1)
+			suite.Require().Equal(uint64(5), sigs[0].Sequence)
+
+			err = authsigning.VerifySignature(
+				signer.PubKey(),
+				authsigning.SignerData{
+					Address:       offlineWallet.AccAddress(),
+					ChainID:       signerData.ChainID,
+					AccountNumber: signerData.AccountNumber,
+					Sequence:      signerData.Sequence,
+					PubKey:        signer.PubKey(),
+				},
+				sigs[0].Data,
+				suite.encodingCfg.TxConfig.SignModeHandler(),
+				tx,
+			)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *WalletTestSuite) TestSignTxSignersOrder() {
+	// Use new keys so that their addresses have never been cached by sdk.AccAddress#String using a different prefix
+	firstSigner := wallet.NewPrivKeySigner(secp256k1.GenPrivKey())
+	secondSigner := wallet.NewPrivKeySigner(secp256k1.GenPrivKey())
+	first := wallet.NewOfflineWallet(firstSigner, "desmos", suite.encodingCfg.TxConfig)
+	second := wallet.NewOfflineWallet(secondSigner, "desmos", suite.encodingCfg.TxConfig)
+	other := wallet.NewOfflineWallet(wallet.NewPrivKeySigner(secp256k1.GenPrivKey()), "desmos", suite.encodingCfg.TxConfig)
+
+	testCases := []struct {
+		name         string
+		signMode     signing.SignMode
+		placeholders bool
+		signers      []*wallet.Wallet
+		shouldErr    bool
+	}{
+		{
+			name:      "wallet that is not a signer returns error",
+			signMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			signers:   []*wallet.Wallet{other},
+			shouldErr: true,
+		},
+		{
+			name:      "missing signatures of the preceding signers return error",
+			signMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			signers:   []*wallet.Wallet{second},
+			shouldErr: true,
+		},
+		{
+			name:         "direct sign mode signatures follow the signers order",
+			signMode:     signing.SignMode_SIGN_MODE_DIRECT,
+			placeholders: true,
+			signers:      []*wallet.Wallet{second, first},
+		},
+		{
+			name:     "amino JSON sign mode signatures follow the signers order",
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			signers:  []*wallet.Wallet{first, second},
+		},
+		{
+			name:         "amino JSON sign mode signatures made in reverse order follow the signers order",
+			signMode:     signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			placeholders: true,
+			signers:      []*wallet.Wallet{second, first},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			builder, err := first.BuildUnsignedTx(context.Background(), types.NewTransactionData(
+				newTestMsgSend(first.AccAddress()),
+				newTestMsgSend(second.AccAddress()),
+			).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("udaric", 2_000))))
+			suite.Require().NoError(err)
+
+			signerData := wallet.SignerData{ChainID: "morpheus-apollo-3", AccountNumber: 10, Sequence: 5}
+			if tc.placeholders {
+				// Set the signer infos of all the signers, as required to sign using SIGN_MODE_DIRECT in any order
+				var sigs []signing.SignatureV2
+				for _, signer := range []wallet.Signer{firstSigner, secondSigner} {
+					sigs = append(sigs, signing.SignatureV2{
+						PubKey:   signer.PubKey(),
+						Data:     &signing.SingleSignatureData{SignMode: tc.signMode},
+						Sequence: signerData.Sequence,
+					})
+				}
+				suite.Require().NoError(builder.SetSignatures(sigs...))
+			}
+
+			for _, signer := range tc.signers {
+				err = signer.SignTx(builder, signerData, tc.signMode)
+				if err != nil {
+					break
+				}
+			}
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			sigs, err := builder.GetTx().GetSignaturesV2()
+			suite.Require().NoError(err)
+			suite.Require().Len(sigs, 2)
+
+			for i, signer := range []*wallet.Wallet{first, second} {
+				suite.Require().Equal(signer.AccAddress(), sdk.MustBech32ifyAddressBytes("desmos", sigs[i].PubKey.Address()))
+
+				err = authsigning.VerifySignature(
+					sigs[i].PubKey,
+					authsigning.SignerData{
+						Address:       signer.AccAddress(),
+						ChainID:       signerData.ChainID,
+						AccountNumber: signerData.AccountNumber,
+						Sequence:      signerData.Sequence,
+						PubKey:        sigs[i].PubKey,
+					},
+					sigs[i].Data,
+					suite.encodingCfg.TxConfig.SignModeHandler(),
+					builder.GetTx(),
+				)
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *WalletTestSuite) TestAccountNumberCaching() {
+	testCases := []struct {
+		name          string
+		authClient    *testutils.MockAuthClient
+		run           func(w *wallet.Wallet, data *types.TransactionData) error
+		shouldErr     bool
+		expectedCalls int
+		check         func(err error)
+	}{
+		{
+			name:       "account number is read from the chain only once",
+			authClient: &testutils.MockAuthClient{AccountNumber: 10, Sequence: 3},
+			run: func(w *wallet.Wallet, data *types.TransactionData) error {
+				_, err := w.BuildTx(data)
+				suite.Require().NoError(err)
+
+				_, err = w.BuildTx(data.WithSequence(4))
+				return err
+			},
+			expectedCalls: 1,
+		},
+		{
+			name:       "account number is not read when set inside the data",
+			authClient: &testutils.MockAuthClient{AccountNumber: 10, Sequence: 3},
+			run: func(w *wallet.Wallet, data *types.TransactionData) error {
+				_, err := w.BuildTx(data.WithAccountNumber(10).WithSequence(3))
+				return err
+			},
+			expectedCalls: 0,
+		},
+		{
+			name:       "missing account returns ErrAccountNotFound",
+			authClient: &testutils.MockAuthClient{Errors: []error{status.Error(codes.NotFound, "account not found")}},
+			run: func(w *wallet.Wallet, data *types.TransactionData) error {
+				_, err := w.BuildTx(data)
+				return err
+			},
+			shouldErr:     true,
+			expectedCalls: 1,
+			check: func(err error) {
+				suite.Require().ErrorIs(err, client.ErrAccountNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			c := suite.newTestClient("0.01udaric")
+			c.AuthClient = tc.authClient
+
+			w := suite.newTestWallet(c)
+
+			data := types.NewTransactionData(
+				newTestMsgSend(w.AccAddress()),
+			).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000))))
+
+			err := tc.run(w, data)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(tc.expectedCalls, tc.authClient.Calls)
+
+			if tc.check != nil {
+				tc.check(err)
+			}
+		})
+	}
+}
+
+func (suite *WalletTestSuite) TestBroadcastSequenceRetries() {
+	wrongSequence := func(expected uint64, got uint64) *coretypes.ResultBroadcastTx {
+		return &coretypes.ResultBroadcastTx{
+			Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			Log:       fmt.Sprintf("account sequence mismatch, expected %d, got %d: incorrect account sequence", expected, got),
+			Hash:      []byte{0x01},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		buildData    func(data *types.TransactionData) *types.TransactionData
+		results      []*coretypes.ResultBroadcastTx
+		expCode      uint32
+		expSequences []uint64
+	}{
+		{
+			name:         "sequence mismatch is not retried by default",
+			buildData:    func(data *types.TransactionData) *types.TransactionData { return data },
+			results:      []*coretypes.ResultBroadcastTx{wrongSequence(5, 3)},
+			expCode:      sdkerrors.ErrWrongSequence.ABCICode(),
+			expSequences: []uint64{3},
+		},
+		{
+			name: "sequence mismatch is retried using the expected sequence",
+			buildData: func(data *types.TransactionData) *types.TransactionData {
+				return data.WithSequenceRetries(2)
+			},
+			results:      []*coretypes.ResultBroadcastTx{wrongSequence(5, 3), wrongSequence(7, 5), {Hash: []byte{0x01}}},
+			expSequences: []uint64{3, 5, 7},
+		},
+		{
+			name: "retries stop after the maximum number of retries",
+			buildData: func(data *types.TransactionData) *types.TransactionData {
+				return data.WithSequenceRetries(1)
+			},
+			results:      []*coretypes.ResultBroadcastTx{wrongSequence(5, 3), wrongSequence(7, 5), {Hash: []byte{0x01}}},
+			expCode:      sdkerrors.ErrWrongSequence.ABCICode(),
+			expSequences: []uint64{3, 5},
+		},
+		{
+			name: "sequence set inside the data is replaced with the expected one",
+			buildData: func(data *types.TransactionData) *types.TransactionData {
+				return data.WithSequence(1).WithSequenceRetries(2)
+			},
+			results:      []*coretypes.ResultBroadcastTx{wrongSequence(4, 1), {Hash: []byte{0x01}}},
+			expSequences: []uint64{1, 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			rpcClient := &testutils.MockRPCClient{
+				Results: tc.results,
+				Errors:  make([]error, len(tc.results)),
+			}
+
+			c := suite.newTestClient("0.01udaric")
+			c.AuthClient = &testutils.MockAuthClient{AccountNumber: 10, Sequence: 3}
+			c.RPCClient = rpcClient
+			w := suite.newTestWallet(c)
+
+			data := tc.buildData(types.NewTransactionData(
+				newTestMsgSend(w.AccAddress()),
+			).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000)))))
+
+			res, err := w.BroadcastTxCtx(context.Background(), data, client.BroadcastSync)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expCode, res.TxResponse.Code)
+			suite.Require().Len(res.Attempts, len(tc.expSequences))
+			suite.Require().Equal(res.Attempts[len(res.Attempts)-1], res.TxResponse)
+
+			// Make sure each attempt has been signed using the expected sequence
+			var sequences []uint64
+			for _, txBytes := range rpcClient.Txs {
+				tx, err := suite.encodingCfg.TxConfig.TxDecoder()(txBytes)
+				suite.Require().NoError(err)
+				sigs, err := tx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+				suite.Require().NoError(err)
+				sequences = append(sequences, sigs[0].Sequence)
+			}
+			suite.Require().Equal(tc.expSequences, sequences)
+		})
+	}
+}
+
+func (suite *WalletTestSuite) TestDryRun() {
+	c := suite.newTestClient("0.01udaric")
+
+	authClient := &testutils.MockAuthClient{AccountNumber: 10, Sequence: 3}
+	txClient := &testutils.MockTxClient{GasUsed: 100_000}
+	c.AuthClient = authClient
+	c.TxClient = txClient
+
+	w := suite.newTestWallet(c)
+
+	data := types.NewTransactionData(
+		newTestMsgSend(w.AccAddress()),
+	).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000)))).WithDryRun()
+
+	res, err := w.BroadcastTxCtx(context.Background(), data, client.BroadcastSync)
+	suite.Require().NoError(err)
+
+	suite.Require().True(res.DryRun)
+	suite.Require().Equal(uint64(200_000), res.GasLimit)
+	suite.Require().Equal(uint64(150_000), res.GasEstimate)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000))), res.Fee)
+	suite.Require().Equal(fmt.Sprintf("%X", tmhash.Sum(res.TxBytes)), res.TxResponse.TxHash)
+	suite.Require().Equal(1, txClient.Simulations)
+
+	// Make sure the transaction has been signed using the sequence read from the chain
+	tx, err := suite.encodingCfg.TxConfig.TxDecoder()(res.TxBytes)
+	suite.Require().NoError(err)
+	sigs, err := tx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), sigs[0].Sequence)
+
+	// Make sure the sequence has not been used
+	again, err := w.BroadcastTxCtx(context.Background(), data, client.BroadcastSync)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.TxResponse.TxHash, again.TxResponse.TxHash)
+	suite.Require().Equal(1, authClient.Calls)
+}
+
+func (suite *WalletTestSuite) TestDryRunGasAuto() {
+	c := suite.newTestClient("0.01udaric")
+
+	txClient := &testutils.MockTxClient{GasUsed: 100_000}
+	c.AuthClient = &testutils.MockAuthClient{AccountNumber: 10, Sequence: 3}
+	c.TxClient = txClient
+
+	w := suite.newTestWallet(c)
+
+	data := types.NewTransactionData(
+		newTestMsgSend(w.AccAddress()),
+	).WithGasAuto().WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000)))).WithDryRun()
+
+	res, err := w.BroadcastTxCtx(context.Background(), data, client.BroadcastSync)
+	suite.Require().NoError(err)
+
+	suite.Require().True(res.DryRun)
+	suite.Require().Equal(uint64(150_000), res.GasLimit)
+	suite.Require().Equal(uint64(150_000), res.GasEstimate)
+	suite.Require().Equal(int64(100_000), res.TxResponse.GasUsed)
+
+	// Make sure the simulation performed to compute the gas has been reused
+	suite.Require().Equal(1, txClient.Simulations)
+}
+
+func (suite *WalletTestSuite) TestDryRunOffline() {
+	w := suite.newTestWallet(nil)
+
+	data := types.NewTransactionData(
+		newTestMsgSend(w.AccAddress()),
+	).WithGasLimit(200_000).WithFeeAmount(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(2000)))).
+		WithAccountNumber(1).WithChainID("morpheus-apollo-3")
+
+	// Broadcasting is not possible without a client
+	_, err := w.BroadcastTxCtx(context.Background(), data, client.BroadcastSync)
+	suite.Require().Error(err)
+
+	// The sequence cannot be read from the chain without a client
+	_, err = w.BroadcastTxCtx(context.Background(), data.WithDryRun(), client.BroadcastSync)
+	suite.Require().ErrorContains(err, "the account number and sequence must be set")
+
+	res, err := w.BroadcastTxCtx(context.Background(), data.WithSequence(3).WithDryRun(), client.BroadcastSync)
+	suite.Require().NoError(err)
+	suite.Require().True(res.DryRun)
+	suite.Require().Equal(fmt.Sprintf("%X", tmhash.Sum(res.TxBytes)), res.TxResponse.TxHash)
+	suite.Require().Zero(res.GasEstimate)
+}
+
+func (suite *WalletTestSuite) TestFeeDenom() {
+	granter := testRecipient
+
+	testCases := []struct {
+		name        string
+		balances    sdk.Coins
+		buildData   func(data *types.TransactionData) *types.TransactionData
+		shouldErr   bool
+		expFees     sdk.Coins
+		expQueried  bool
+		expFeePayer func(w *wallet.Wallet) string
+	}{
+		{
+			name:     "explicit fee denom is used without querying the balance",
+			balances: sdk.NewCoins(),
+			buildData: func(data *types.TransactionData) *types.TransactionData {
+				return data.WithFeeDenom("uatom")
+			},
+			expFees: sdk.NewCoins(sdk.NewInt64Coin("uatom", 4_000)),
+		},
+		{
+			name:       "first affordable denom is used",
+			balances:   sdk.NewCoins(sdk.NewInt64Coin("udaric", 1_000), sdk.NewInt64Coin("uatom", 5_000)),
+			buildData:  func(data *types.TransactionData) *types.TransactionData { return data },
+			expFees:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 4_000)),
+			expQueried: true,
+			expFeePayer: func(w *wallet.Wallet) string {
+				return w.AccAddress()
+			},
+		},
+		{
+			name:     "fee granter balance is checked when set",
+			balances: sdk.NewCoins(sdk.NewInt64Coin("udaric", 2_000)),
+			buildData: func(data *types.TransactionData) *types.TransactionData {
+				return data.WithFeeGranter(sdk.MustAccAddressFromBech32(granter))
+			},
+			expFees:    sdk.NewCoins(sdk.NewInt64Coin("udaric", 2_000)),
+			expQueried: true,
+			expFeePayer: func(_ *wallet.Wallet) string {
+				return granter
+			},
+		},
+		{
+			name:       "error is returned when no denom is affordable",
+			balances:   sdk.NewCoins(),
+			buildData:  func(data *types.TransactionData) *types.TransactionData { return data },
+			shouldErr:  true,
+			expQueried: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			c := suite.newTestClient("0.01udaric,0.02uatom")
+
+			bankClient := &testutils.MockBankClient{Balances: tc.balances}
+			c.BankClient = bankClient
+
+			w := suite.newTestWallet(c)
+
+			data := tc.buildData(types.NewTransactionData(
+				newTestMsgSend(w.AccAddress()),
+			).WithGasLimit(200_000).WithFeeAuto())
+
+			builder, err := w.BuildUnsignedTx(context.Background(), data)
+			if tc.shouldErr {
+				suite.Require().ErrorIs(err, client.ErrInsufficientFunds)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFees, builder.GetTx().GetFee())
+			}
+
+			suite.Require().Equal(tc.expQueried, len(bankClient.Addresses) > 0)
+			if tc.expFeePayer != nil {
+				suite.Require().Equal(tc.expFeePayer(w), bankClient.Addresses[0])
+			}
+		})
+	}
+}
+
+func (suite *WalletTestSuite) TestFeeLimits() {
+	offlineWallet := suite.newTestWallet(nil)
+	offlineWallet.FeeLimits = &client.FeeLimits{
+		MaxGas: 300_000,
+		MaxFee: sdk.NewCoins(sdk.NewInt64Coin("udaric", 3_000)),
+	}
+
+	testCases := []struct {
+		name      string
+		gasLimit  uint64
+		fee       int64
+		shouldErr bool
+	}{
+		{
+			name:     "transaction within the limits is signed",
+			gasLimit: 200_000,
+			fee:      2_000,
+		},
+		{
+			name:      "transaction exceeding the maximum gas is not signed",
+			gasLimit:  400_000,
+			fee:       2_000,
+			shouldErr: true,
+		},
+		{
+			name:      "transaction exceeding the maximum fee is not signed",
+			gasLimit:  200_000,
+			fee:       3_001,
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			data := types.NewTransactionData(
+				newTestMsgSend(offlineWallet.AccAddress()),
+			).
+				WithGasLimit(tc.gasLimit).
+				WithFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("udaric", tc.fee))).
+				WithAccountNumber(10).
+				WithSequence(1).
+				WithChainID("morpheus-apollo-3")
+
+			builder, err := offlineWallet.BuildTxCtx(context.Background(), data)
+			if tc.shouldErr {
+				suite.Require().ErrorIs(err, client.ErrFeeLimitExceeded)
+				suite.Require().Nil(builder)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *WalletTestSuite) TestTimeoutHeight() {
+	c := suite.newTestClient("0.01udaric")
+	c.RPCClient = &testutils.MockRPCClient{Height: 100}
+
+	testCases := []struct {
+		name             string
+		client           *client.Client
+		buildData        func(data *types.TransactionData) *types.TransactionData
+		shouldErr        bool
+		expTimeoutHeight uint64
+	}{
+		{
+			name:             "no timeout is set by default",
+			buildData:        func(data *types.TransactionData) *types.TransactionData { return data },
+			expTimeoutHeight: 0,
+		},
+		{
+			name: "timeout height is set offline",
+			buildData: func(data *types.TransactionData) *types.TransactionData {
+				return data.WithTimeoutHeight(150)
+			},
+			expTimeoutHeight: 150,
+		},
+		{
+			name: "timeout blocks return error offline",
+			buildData: func(data *types.TransactionData) *types.TransactionData {
+				return data.WithTimeoutBlocks(10)
+			},
+			shouldErr: true,
+		},
+		{
+			name:   "timeout blocks are added to the latest height",
+			client: c,
+			buildData: func(data *types.TransactionData) *types.TransactionData {
+				return data.WithTimeoutBlocks(10)
+			},
+			expTimeoutHeight: 110,
+		},
+		{
+			name:   "timeout height takes precedence over timeout blocks",
+			client: c,
+			buildData: func(data *types.TransactionData) *types.TransactionData {
+				return data.WithTimeoutHeight(150).WithTimeoutBlocks(10)
+			},
+			expTimeoutHeight: 150,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			w := suite.newTestWallet(tc.client)
+
+			data := tc.buildData(types.NewTransactionData(
+				newTestMsgSend(w.AccAddress()),
+			).
+				WithGasLimit(200_000).
+				WithFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("udaric", 2_000))).
+				WithAccountNumber(10).
+				WithSequence(1).
+				WithChainID("morpheus-apollo-3"))
+
+			builder, err := w.BuildTxCtx(context.Background(), data)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTimeoutHeight, builder.GetTx().GetTimeoutHeight())
+			}
+		})
+	}
+}
+
+// basicTxConfig is a sdkclient.TxConfig building transactions that do not support extension options
+type basicTxConfig struct {
+	sdkclient.TxConfig
+}
+
+func (c basicTxConfig) NewTxBuilder() sdkclient.TxBuilder {
+	return struct{ sdkclient.TxBuilder }{c.TxConfig.NewTxBuilder()}
+}
+
+func (suite *WalletTestSuite) TestExtensionOptions() {
+	signer, err := wallet.NewMnemonicSigner(testMnemonic, testHDPath, hd.Secp256k1)
+	suite.Require().NoError(err)
+
+	option := &codectypes.Any{TypeUrl: "/ethermint.types.v1.ExtensionOptionsWeb3Tx", Value: []byte{0x08, 0x01}}
+	nonCriticalOption := &codectypes.Any{TypeUrl: "/ethermint.types.v1.ExtensionOptionDynamicFeeTx", Value: []byte{0x0a, 0x01, 0x31}}
+
+	testCases := []struct {
+		name      string
+		txConfig  sdkclient.TxConfig
+		shouldErr bool
+	}{
+		{
+			name:     "extension options are set",
+			txConfig: suite.encodingCfg.TxConfig,
+		},
+		{
+			name:      "unsupported tx builder returns error",
+			txConfig:  basicTxConfig{suite.encodingCfg.TxConfig},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			w := wallet.NewOfflineWallet(signer, "desmos", tc.txConfig)
+
+			data := types.NewTransactionData(
+				newTestMsgSend(w.AccAddress()),
+			).
+				WithGasLimit(200_000).
+				WithFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("udaric", 2_000))).
+				WithExtensionOptions(option).
+				WithNonCriticalExtensionOptions(nonCriticalOption)
+
+			builder, err := w.BuildUnsignedTx(context.Background(), data)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			tx, ok := builder.GetTx().(interface {
+				GetExtensionOptions() []*codectypes.Any
+				GetNonCriticalExtensionOptions() []*codectypes.Any
+			})
+			suite.Require().True(ok)
+			suite.Require().Equal([]*codectypes.Any{option}, tx.GetExtensionOptions())
+			suite.Require().Equal([]*codectypes.Any{nonCriticalOption}, tx.GetNonCriticalExtensionOptions())
+		})
+	}
+}